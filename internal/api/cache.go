@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Forever is a TTL for responses that never change, such as the results of
+// a finished season.
+const Forever time.Duration = -1
+
+// ErrNotCached is returned in offline mode for URLs that were never cached.
+var ErrNotCached = errors.New("offline: no cached data for this request")
+
+// Cache stores raw API responses on disk, one file per URL. The file's
+// modification time is when the response was fetched.
+type Cache struct {
+	Dir string
+}
+
+// DefaultCacheDir is f1-tui under the user cache directory
+// ($XDG_CACHE_HOME or ~/.cache on Linux).
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "f1-tui"), nil
+}
+
+func NewCache(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Cache{Dir: dir}, nil
+}
+
+func (c *Cache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the cached body for url and when it was fetched.
+func (c *Cache) Get(url string) ([]byte, time.Time, bool) {
+	p := c.path(url)
+	st, err := os.Stat(p)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	return b, st.ModTime(), true
+}
+
+// Put stores body for url, replacing any previous entry atomically.
+func (c *Cache) Put(url string, body []byte) error {
+	tmp, err := os.CreateTemp(c.Dir, "put-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(url))
+}
+
+// FetchInfo describes where the data returned by a DataSource call came
+// from.
+type FetchInfo struct {
+	FromCache bool      // at least one response was served from the cache
+	Stale     bool      // at least one response was past its TTL
+	FetchedAt time.Time // when the oldest response was fetched
+}
+
+type fetchRecorder struct {
+	mu   sync.Mutex
+	info FetchInfo
+}
+
+type fetchRecorderKey struct{}
+
+// WithFetchInfo returns a context that records the FetchInfo of the
+// DataSource calls made with it, and a function reporting it so far.
+func WithFetchInfo(ctx context.Context) (context.Context, func() FetchInfo) {
+	rec := &fetchRecorder{}
+	report := func() FetchInfo {
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		return rec.info
+	}
+	return context.WithValue(ctx, fetchRecorderKey{}, rec), report
+}
+
+func recordFetch(ctx context.Context, fetched time.Time, fromCache, stale bool) {
+	rec, ok := ctx.Value(fetchRecorderKey{}).(*fetchRecorder)
+	if !ok {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.info.FromCache = rec.info.FromCache || fromCache
+	rec.info.Stale = rec.info.Stale || stale
+	if rec.info.FetchedAt.IsZero() || fetched.Before(rec.info.FetchedAt) {
+		rec.info.FetchedAt = fetched
+	}
+}
+
+type maxAgeKey struct{}
+
+// WithMaxAge caps the age of cached responses accepted by DataSource calls
+// made with ctx, e.g. to poll a live session.
+func WithMaxAge(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, maxAgeKey{}, d)
+}
+
+// capTTL lowers ttl to the max age set on ctx, if any.
+func capTTL(ctx context.Context, ttl time.Duration) time.Duration {
+	d, ok := ctx.Value(maxAgeKey{}).(time.Duration)
+	if ok && (ttl == Forever || d < ttl) {
+		return d
+	}
+	return ttl
+}
+
+// fresh reports whether a response fetched at fetched is within ttl.
+func fresh(fetched time.Time, ttl time.Duration) bool {
+	return ttl == Forever || time.Since(fetched) < ttl
+}
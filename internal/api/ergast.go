@@ -6,146 +6,585 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
 )
 
-const ergastBase = "http://api.jolpi.ca/ergast/f1"
+const DefaultErgastBase = "http://api.jolpi.ca/ergast/f1"
 
-func FetchCurrentSchedule(ctx context.Context) ([]models.Race, error) {
-	url := fmt.Sprintf("%s/current.json", ergastBase)
-	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	resp, err := http.DefaultClient.Do(req)
+// Ergast talks to a Jolpica/Ergast compatible API. With a Cache, responses
+// are stored on disk and reused until their TTL runs out; Offline serves
+// only from the cache.
+type Ergast struct {
+	BaseURL string
+	Client  *http.Client
+	Cache   *Cache
+	Offline bool
+}
+
+var _ DataSource = (*Ergast)(nil)
+
+// NewErgast returns a client for baseURL, or for DefaultErgastBase when
+// baseURL is empty.
+func NewErgast(baseURL string) *Ergast {
+	if baseURL == "" {
+		baseURL = DefaultErgastBase
+	}
+	return &Ergast{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Client:  http.DefaultClient,
+	}
+}
+
+func (e *Ergast) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.Client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
+	if resp.StatusCode != http.StatusOK {
 		b, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("ergast schedule error: %s", string(b))
+		return nil, fmt.Errorf("ergast error: status %d: %s", resp.StatusCode, string(b))
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// getJSON decodes url into v. A cached response is used while it is within
+// the TTL that ttl derives from the decoded v, always when offline, and as a
+// fallback when the request fails.
+func (e *Ergast) getJSON(ctx context.Context, url string, v any, ttl func() time.Duration) error {
+	var (
+		fetched time.Time
+		cached  bool
+	)
+	if e.Cache != nil {
+		var body []byte
+		body, fetched, cached = e.Cache.Get(url)
+		if cached && json.Unmarshal(body, v) == nil {
+			stale := !fresh(fetched, capTTL(ctx, ttl()))
+			if !stale || e.Offline {
+				recordFetch(ctx, fetched, true, stale)
+				return nil
+			}
+		} else {
+			cached = false
+		}
+	}
+	if e.Offline {
+		return ErrNotCached
+	}
+
+	body, err := e.fetch(ctx, url)
+	if err != nil {
+		if cached {
+			recordFetch(ctx, fetched, true, true)
+			return nil
+		}
+		return err
+	}
+
+	reflect.ValueOf(v).Elem().SetZero()
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("decode %s: %w", url, err)
+	}
+	recordFetch(ctx, time.Now(), false, false)
+	if e.Cache != nil {
+		_ = e.Cache.Put(url, body)
 	}
+	return nil
+}
 
+func (e *Ergast) fetchMRData(ctx context.Context, url string, ttl func(models.ErgastMRData) time.Duration) (models.ErgastMRData, error) {
 	var outer struct {
 		MRData models.ErgastMRData `json:"MRData"`
 	}
+	err := e.getJSON(ctx, url, &outer, func() time.Duration {
+		d := ttl(outer.MRData)
+		if aliased(url) && (d == Forever || d > aliasTTL) {
+			return aliasTTL
+		}
+		return d
+	})
+	if err != nil {
+		return models.ErgastMRData{}, err
+	}
+	return outer.MRData, nil
+}
 
-	dec := json.NewDecoder(resp.Body)
-	if err := dec.Decode(&outer); err != nil {
-		return nil, err
+// aliasTTL is the longest a response is kept for a URL naming its season
+// or round "current" or "last": what they point to moves on with the
+// calendar, however final the data it pointed to was.
+const aliasTTL = 5 * time.Minute
+
+// aliased reports whether url has a "current" or "last" path segment.
+func aliased(url string) bool {
+	path, _, _ := strings.Cut(url, "?")
+	for _, seg := range strings.Split(path, "/") {
+		if seg == "current" || seg == "last" {
+			return true
+		}
+	}
+	return false
+}
+
+// seasonTTL keeps anything about a finished season forever and data about
+// the ongoing one for current.
+func seasonTTL(season string, current time.Duration) func(models.ErgastMRData) time.Duration {
+	return func(models.ErgastMRData) time.Duration {
+		if y, err := strconv.Atoi(season); err == nil && y < time.Now().Year() {
+			return Forever
+		}
+		return current
+	}
+}
+
+// resultsTTL treats results as final three days after the race, and
+// re-checks often while a weekend is still in progress.
+func resultsTTL(data models.ErgastMRData) time.Duration {
+	races := data.RaceTable.Races
+	if len(races) > 0 {
+		if d, err := time.Parse("2006-01-02", races[0].Date); err == nil && time.Since(d) > 72*time.Hour {
+			return Forever
+		}
+	}
+	return 5 * time.Minute
+}
+
+// pageSize is the largest page Ergast serves.
+const pageSize = 100
+
+// fetchAllPages fetches every page of a paginated endpoint, calling each
+// with the data of every page in order.
+func (e *Ergast) fetchAllPages(ctx context.Context, endpoint string, ttl func(models.ErgastMRData) time.Duration, each func(models.ErgastMRData)) error {
+	for offset := 0; ; offset += pageSize {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", endpoint, pageSize, offset)
+		data, err := e.fetchMRData(ctx, url, ttl)
+		if err != nil {
+			return err
+		}
+		each(data)
+
+		total, err := strconv.Atoi(data.Total)
+		if err != nil || offset+pageSize >= total {
+			return nil
+		}
 	}
+}
 
-	return outer.MRData.RaceTable.Races, nil
+func (e *Ergast) Schedule(ctx context.Context, season string) ([]models.Race, error) {
+	if season == "" {
+		season = "current"
+	}
+	url := fmt.Sprintf("%s/%s.json", e.BaseURL, season)
+	data, err := e.fetchMRData(ctx, url, seasonTTL(season, 6*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	return data.RaceTable.Races, nil
 }
 
-func ResultsURL(season, round string) string {
+func (e *Ergast) ResultsURL(season, round string) string {
 	return fmt.Sprintf("https://motorsportstats.com/results/formula-one/%s/round-%s", season, round)
 }
 
-func FetchSessionResults(ctx context.Context, season, round, sessionType string) ([]models.DriverResult, error) {
-    var endpoint string
-    switch sessionType {
-    case "Race":
-        endpoint = fmt.Sprintf("%s/%s/%s/results.json", ergastBase, season, round)
-    case "Qualifying":
-        endpoint = fmt.Sprintf("%s/%s/%s/qualifying.json", ergastBase, season, round)
-    case "Sprint":
-        endpoint = fmt.Sprintf("%s/%s/%s/sprint.json", ergastBase, season, round)
-    default:
-        return nil, fmt.Errorf("detailed results not available for practice sessions")
-    }
-
-    req, _ := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-    resp, err := http.DefaultClient.Do(req)
-    if err != nil {
-        return nil, err
-    }
-    defer resp.Body.Close()
-
-    if resp.StatusCode != 200 {
-        return nil, fmt.Errorf("API error: status %d", resp.StatusCode)
-    }
-
-    var data map[string]interface{}
-    if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-        return nil, err
-    }
-
-    results := []models.DriverResult{}
-    mrData := data["MRData"].(map[string]interface{})
-
-    if sessionType == "Qualifying" {
-        raceTable := mrData["RaceTable"].(map[string]interface{})
-        races := raceTable["Races"].([]interface{})
-        if len(races) == 0 {
-            return nil, fmt.Errorf("no results available yet")
-        }
-        qualResults := races[0].(map[string]interface{})["QualifyingResults"].([]interface{})
-
-        for _, r := range qualResults {
-            result := r.(map[string]interface{})
-            driver := result["Driver"].(map[string]interface{})
-            constructor := result["Constructor"].(map[string]interface{})
-
-            q3Time := "-"
-            if q3, ok := result["Q3"].(string); ok && q3 != "" {
-                q3Time = q3
-            } else if q2, ok := result["Q2"].(string); ok && q2 != "" {
-                q3Time = q2
-            } else if q1, ok := result["Q1"].(string); ok && q1 != "" {
-                q3Time = q1
-            }
-
-            results = append(results, models.DriverResult{
-                Position:    result["position"].(string),
-                Driver:      fmt.Sprintf("%s %s", driver["givenName"], driver["familyName"]),
-                Constructor: constructor["name"].(string),
-                Time:        q3Time,
-                Status:      "Completed",
-            })
-        }
-    } else {
-        raceTable := mrData["RaceTable"].(map[string]interface{})
-        races := raceTable["Races"].([]interface{})
-        if len(races) == 0 {
-            return nil, fmt.Errorf("no results available yet")
-        }
-
-        var raceResults []interface{}
-        race := races[0].(map[string]interface{})
-        if sessionType == "Sprint" {
-            raceResults = race["SprintResults"].([]interface{})
-        } else {
-            raceResults = race["Results"].([]interface{})
-        }
-
-        for _, r := range raceResults {
-            result := r.(map[string]interface{})
-            driver := result["Driver"].(map[string]interface{})
-            constructor := result["Constructor"].(map[string]interface{})
-
-            time := "-"
-            if t, ok := result["Time"].(map[string]interface{}); ok {
-                time = t["time"].(string)
-            }
-
-            points := ""
-            if sessionType == "Race" {
-                if p, ok := result["points"].(string); ok {
-                    points = p
-                }
-            }
-
-            results = append(results, models.DriverResult{
-                Position:    result["position"].(string),
-                Driver:      fmt.Sprintf("%s %s", driver["givenName"], driver["familyName"]),
-                Constructor: constructor["name"].(string),
-                Time:        time,
-                Status:      result["status"].(string),
-                Points:      points,
-            })
-        }
-    }
-
-    return results, nil
+func (e *Ergast) SessionResults(ctx context.Context, season, round, sessionType string) ([]models.DriverResult, error) {
+	var endpoint string
+	switch sessionType {
+	case "Race":
+		endpoint = fmt.Sprintf("%s/%s/%s/results.json", e.BaseURL, season, round)
+	case "Qualifying":
+		endpoint = fmt.Sprintf("%s/%s/%s/qualifying.json", e.BaseURL, season, round)
+	case "Sprint":
+		endpoint = fmt.Sprintf("%s/%s/%s/sprint.json", e.BaseURL, season, round)
+	default:
+		return nil, fmt.Errorf("detailed results not available for practice sessions")
+	}
+
+	data, err := e.fetchMRData(ctx, endpoint, resultsTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	races := data.RaceTable.Races
+	if len(races) == 0 {
+		return nil, fmt.Errorf("no results available yet")
+	}
+	race := races[0]
+
+	var results []models.DriverResult
+	switch sessionType {
+	case "Qualifying":
+		if len(race.QualifyingResults) == 0 {
+			return nil, fmt.Errorf("no qualifying results for %s round %s", season, round)
+		}
+		for _, q := range race.QualifyingResults {
+			results = append(results, qualifyingRow(q))
+		}
+	case "Sprint":
+		if len(race.SprintResults) == 0 {
+			return nil, fmt.Errorf("no sprint results for %s round %s", season, round)
+		}
+		for _, r := range race.SprintResults {
+			results = append(results, raceRow(r))
+		}
+	default:
+		if len(race.Results) == 0 {
+			return nil, fmt.Errorf("no race results for %s round %s", season, round)
+		}
+		for _, r := range race.Results {
+			results = append(results, raceRow(r))
+		}
+	}
+
+	return results, nil
+}
+
+func driverName(d models.Driver) string {
+	return strings.TrimSpace(d.GivenName + " " + d.FamilyName)
+}
+
+func qualifyingRow(q models.QualifyingResult) models.DriverResult {
+	best := "-"
+	switch {
+	case q.Q3 != "":
+		best = q.Q3
+	case q.Q2 != "":
+		best = q.Q2
+	case q.Q1 != "":
+		best = q.Q1
+	}
+
+	return models.DriverResult{
+		Position:      q.Position,
+		DriverID:      q.Driver.DriverID,
+		Driver:        driverName(q.Driver),
+		ConstructorID: q.Constructor.ConstructorID,
+		Constructor:   q.Constructor.Name,
+		Time:          best,
+		Status:        "Completed",
+		Q1:            q.Q1,
+		Q2:            q.Q2,
+		Q3:            q.Q3,
+	}
+}
+
+func raceRow(r models.RaceResult) models.DriverResult {
+	t := "-"
+	if r.Time != nil && r.Time.Time != "" {
+		t = r.Time.Time
+	}
+
+	var fastest, rank string
+	if r.FastestLap != nil {
+		fastest, rank = r.FastestLap.Time.Time, r.FastestLap.Rank
+	}
+
+	return models.DriverResult{
+		Position:      r.Position,
+		PositionText:  r.PositionText,
+		DriverID:      r.Driver.DriverID,
+		Driver:        driverName(r.Driver),
+		ConstructorID: r.Constructor.ConstructorID,
+		Constructor:   r.Constructor.Name,
+		Time:          t,
+		Status:        r.Status,
+		Points:        r.Points,
+
+		Number:         r.Number,
+		Grid:           r.Grid,
+		Laps:           r.Laps,
+		FastestLap:     fastest,
+		FastestLapRank: rank,
+	}
+}
+
+func (e *Ergast) DriverStandings(ctx context.Context, season, round string) (models.DriverStandingsTable, error) {
+	endpoint := fmt.Sprintf("%s/%s/driverStandings.json", e.BaseURL, season)
+	if round != "" {
+		endpoint = fmt.Sprintf("%s/%s/%s/driverStandings.json", e.BaseURL, season, round)
+	}
+
+	data, err := e.fetchMRData(ctx, endpoint, seasonTTL(season, time.Hour))
+	if err != nil {
+		return models.DriverStandingsTable{}, err
+	}
+
+	lists := data.StandingsTable.StandingsLists
+	if len(lists) == 0 {
+		return models.DriverStandingsTable{}, fmt.Errorf("no driver standings for %s yet", season)
+	}
+
+	list := lists[0]
+	table := models.DriverStandingsTable{Season: list.Season, Round: list.Round}
+	for _, s := range list.DriverStandings {
+		team := ""
+		if n := len(s.Constructors); n > 0 {
+			team = s.Constructors[n-1].Name
+		}
+		var teamIDs []string
+		for _, c := range s.Constructors {
+			teamIDs = append(teamIDs, c.ConstructorID)
+		}
+		table.Standings = append(table.Standings, models.DriverStanding{
+			Position:       positionOrText(s.Position, s.PositionText),
+			DriverID:       s.Driver.DriverID,
+			Driver:         driverName(s.Driver),
+			Nationality:    s.Driver.Nationality,
+			Constructor:    team,
+			ConstructorIDs: teamIDs,
+			Wins:           s.Wins,
+			Points:         s.Points,
+		})
+	}
+	return table, nil
+}
+
+func (e *Ergast) ConstructorStandings(ctx context.Context, season, round string) (models.ConstructorStandingsTable, error) {
+	endpoint := fmt.Sprintf("%s/%s/constructorStandings.json", e.BaseURL, season)
+	if round != "" {
+		endpoint = fmt.Sprintf("%s/%s/%s/constructorStandings.json", e.BaseURL, season, round)
+	}
+
+	data, err := e.fetchMRData(ctx, endpoint, seasonTTL(season, time.Hour))
+	if err != nil {
+		return models.ConstructorStandingsTable{}, err
+	}
+
+	lists := data.StandingsTable.StandingsLists
+	if len(lists) == 0 {
+		// The constructors' championship only exists from 1958.
+		return models.ConstructorStandingsTable{}, fmt.Errorf("no constructor standings for %s", season)
+	}
+
+	list := lists[0]
+	table := models.ConstructorStandingsTable{Season: list.Season, Round: list.Round}
+	for _, s := range list.ConstructorStandings {
+		table.Standings = append(table.Standings, models.ConstructorStanding{
+			Position:      positionOrText(s.Position, s.PositionText),
+			ConstructorID: s.Constructor.ConstructorID,
+			Constructor:   s.Constructor.Name,
+			Nationality:   s.Constructor.Nationality,
+			Wins:          s.Wins,
+			Points:        s.Points,
+		})
+	}
+	return table, nil
+}
+
+// positionOrText prefers the numeric position, falling back to Ergast's
+// positionText ("-", "D") for unclassified entries.
+func positionOrText(pos, text string) string {
+	if pos != "" {
+		return pos
+	}
+	return text
+}
+
+func (e *Ergast) DriverLaps(ctx context.Context, season, round, driverID string) ([]models.LapTime, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/drivers/%s/laps.json", e.BaseURL, season, round, driverID)
+
+	var laps []models.LapTime
+	err := e.fetchAllPages(ctx, endpoint, resultsTTL, func(data models.ErgastMRData) {
+		for _, race := range data.RaceTable.Races {
+			for _, lap := range race.Laps {
+				n, _ := strconv.Atoi(lap.Number)
+				for _, t := range lap.Timings {
+					d, _ := utils.ParseLapTime(t.Time)
+					laps = append(laps, models.LapTime{
+						DriverID: t.DriverID,
+						Lap:      n,
+						Position: t.Position,
+						Time:     t.Time,
+						Duration: d,
+					})
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(laps) == 0 {
+		return nil, fmt.Errorf("no lap times for %s in %s round %s", driverID, season, round)
+	}
+	return laps, nil
+}
+
+func (e *Ergast) FastestLap(ctx context.Context, season, round string) (models.LapTime, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/fastest/1/results.json", e.BaseURL, season, round)
+	data, err := e.fetchMRData(ctx, endpoint, resultsTTL)
+	if err != nil {
+		return models.LapTime{}, err
+	}
+
+	races := data.RaceTable.Races
+	if len(races) == 0 || len(races[0].Results) == 0 || races[0].Results[0].FastestLap == nil {
+		return models.LapTime{}, fmt.Errorf("no fastest lap recorded for %s round %s", season, round)
+	}
+
+	r := races[0].Results[0]
+	n, _ := strconv.Atoi(r.FastestLap.Lap)
+	d, _ := utils.ParseLapTime(r.FastestLap.Time.Time)
+	return models.LapTime{
+		DriverID: r.Driver.DriverID,
+		Driver:   driverName(r.Driver),
+		Lap:      n,
+		Time:     r.FastestLap.Time.Time,
+		Duration: d,
+	}, nil
+}
+
+func (e *Ergast) PitStops(ctx context.Context, season, round string) ([]models.PitStop, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/pitstops.json", e.BaseURL, season, round)
+
+	var stops []models.PitStop
+	err := e.fetchAllPages(ctx, endpoint, resultsTTL, func(data models.ErgastMRData) {
+		for _, race := range data.RaceTable.Races {
+			for _, p := range race.PitStops {
+				stop, _ := strconv.Atoi(p.Stop)
+				lap, _ := strconv.Atoi(p.Lap)
+				d, _ := utils.ParseLapTime(p.Duration)
+				stops = append(stops, models.PitStop{
+					DriverID:  p.DriverID,
+					Stop:      stop,
+					Lap:       lap,
+					TimeOfDay: p.Time,
+					Duration:  p.Duration,
+					PitTime:   d,
+				})
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(stops) == 0 {
+		// Ergast has pit stop data from 2012 on.
+		return nil, fmt.Errorf("no pit stop data for %s round %s", season, round)
+	}
+	return stops, nil
+}
+
+func (e *Ergast) Driver(ctx context.Context, driverID string) (models.Driver, error) {
+	endpoint := fmt.Sprintf("%s/drivers/%s.json", e.BaseURL, driverID)
+	data, err := e.fetchMRData(ctx, endpoint, func(models.ErgastMRData) time.Duration { return 24 * time.Hour })
+	if err != nil {
+		return models.Driver{}, err
+	}
+	if len(data.DriverTable.Drivers) == 0 {
+		return models.Driver{}, fmt.Errorf("unknown driver %q", driverID)
+	}
+	return data.DriverTable.Drivers[0], nil
+}
+
+func (e *Ergast) DriverSeasonResults(ctx context.Context, season, driverID string) ([]models.SeasonResult, error) {
+	endpoint := fmt.Sprintf("%s/%s/drivers/%s/results.json", e.BaseURL, season, driverID)
+	return e.seasonResults(ctx, endpoint, season)
+}
+
+func (e *Ergast) RaceWinners(ctx context.Context, season string) ([]models.SeasonResult, error) {
+	endpoint := fmt.Sprintf("%s/%s/results/1.json", e.BaseURL, season)
+	return e.seasonResults(ctx, endpoint, season)
+}
+
+// seasonResults flattens the race and sprint results of a season
+// endpoint.
+func (e *Ergast) seasonResults(ctx context.Context, endpoint, season string) ([]models.SeasonResult, error) {
+	var results []models.SeasonResult
+	err := e.fetchAllPages(ctx, endpoint, seasonTTL(season, time.Hour), func(data models.ErgastMRData) {
+		for _, race := range data.RaceTable.Races {
+			sprint := len(race.SprintResults) > 0
+			for _, r := range append(race.Results, race.SprintResults...) {
+				results = append(results, models.SeasonResult{
+					Round:       race.Round,
+					RaceName:    race.RaceName,
+					Sprint:      sprint,
+					DriverID:    r.Driver.DriverID,
+					Driver:      driverName(r.Driver),
+					Constructor: r.Constructor.Name,
+					Grid:        r.Grid,
+					Position:    positionOrText(r.Position, r.PositionText),
+					Points:      r.Points,
+					Status:      r.Status,
+				})
+			}
+		}
+	})
+	return results, err
+}
+
+// DriverCareer counts the driver's entries through Ergast's totals, one
+// single-item request per figure. The requests go one after another, as a
+// burst of them runs into the API's rate limit.
+func (e *Ergast) DriverCareer(ctx context.Context, driverID string) (models.CareerStats, error) {
+	base := fmt.Sprintf("%s/drivers/%s", e.BaseURL, driverID)
+	paths := []string{
+		"seasons.json",
+		"results.json",
+		"results/1.json",
+		"grid/1/results.json",
+		"results/2.json",
+		"results/3.json",
+	}
+
+	counts := make([]int, len(paths))
+	for i, p := range paths {
+		n, err := e.count(ctx, base+"/"+p)
+		if err != nil {
+			return models.CareerStats{}, err
+		}
+		counts[i] = n
+	}
+	return models.CareerStats{
+		Seasons: counts[0],
+		Starts:  counts[1],
+		Wins:    counts[2],
+		Poles:   counts[3],
+		Podiums: counts[2] + counts[4] + counts[5],
+	}, nil
+}
+
+// count returns the number of items endpoint has, without fetching them.
+func (e *Ergast) count(ctx context.Context, endpoint string) (int, error) {
+	data, err := e.fetchMRData(ctx, endpoint+"?limit=1", func(models.ErgastMRData) time.Duration { return 6 * time.Hour })
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(data.Total)
+}
+
+func (e *Ergast) Constructor(ctx context.Context, constructorID string) (models.Constructor, error) {
+	endpoint := fmt.Sprintf("%s/constructors/%s.json", e.BaseURL, constructorID)
+	data, err := e.fetchMRData(ctx, endpoint, func(models.ErgastMRData) time.Duration { return 24 * time.Hour })
+	if err != nil {
+		return models.Constructor{}, err
+	}
+	if len(data.ConstructorTable.Constructors) == 0 {
+		return models.Constructor{}, fmt.Errorf("unknown constructor %q", constructorID)
+	}
+	return data.ConstructorTable.Constructors[0], nil
+}
+
+// ConstructorSeasonResults returns the race results of every car the
+// constructor entered in a season, followed by its sprint results.
+func (e *Ergast) ConstructorSeasonResults(ctx context.Context, season, constructorID string) ([]models.SeasonResult, error) {
+	races, err := e.seasonResults(ctx, fmt.Sprintf("%s/%s/constructors/%s/results.json", e.BaseURL, season, constructorID), season)
+	if err != nil {
+		return nil, err
+	}
+	sprints, err := e.seasonResults(ctx, fmt.Sprintf("%s/%s/constructors/%s/sprint.json", e.BaseURL, season, constructorID), season)
+	if err != nil {
+		return nil, err
+	}
+	return append(races, sprints...), nil
 }
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// ergastServer serves testdata/ergast/fixture for every request.
+func ergastServer(t *testing.T, fixture string) *Ergast {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", "ergast", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	}))
+	t.Cleanup(srv.Close)
+	return NewErgast(srv.URL)
+}
+
+func TestSessionResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		session string
+		want    []models.DriverResult
+		wantErr bool
+	}{
+		{
+			name:    "race with a DNF, no time and no constructor",
+			fixture: "race_dnf.json",
+			session: "Race",
+			want: []models.DriverResult{
+				{Position: "1", PositionText: "1", DriverID: "norris", Driver: "Lando Norris", ConstructorID: "mclaren", Constructor: "McLaren", Time: "1:42:06.304", Status: "Finished", Points: "25", Number: "4", Grid: "1", Laps: "57", FastestLap: "1:22.167", FastestLapRank: "1"},
+				{Position: "2", PositionText: "R", DriverID: "lawson", Driver: "Liam Lawson", ConstructorID: "red_bull", Constructor: "Red Bull", Time: "-", Status: "Retired", Points: "0", Number: "30", Grid: "0", Laps: "46"},
+				{Position: "3", PositionText: "W", DriverID: "nobody", Driver: "Nobody", Time: "-", Status: "Withdrew", Points: "0", Number: "99", Laps: "0"},
+			},
+		},
+		{
+			name:    "sprint with points and without fastest laps",
+			fixture: "sprint_no_fastest.json",
+			session: "Sprint",
+			want: []models.DriverResult{
+				{Position: "1", PositionText: "1", DriverID: "hamilton", Driver: "Lewis Hamilton", ConstructorID: "ferrari", Constructor: "Ferrari", Time: "30:39.965", Status: "Finished", Points: "8", Number: "44", Grid: "1", Laps: "19"},
+			},
+		},
+		{
+			name:    "qualifying knocked out in Q1",
+			fixture: "qualifying_partial.json",
+			session: "Qualifying",
+			want: []models.DriverResult{
+				{Position: "1", DriverID: "norris", Driver: "Lando Norris", ConstructorID: "mclaren", Constructor: "McLaren", Time: "1:15.096", Status: "Completed", Q1: "1:15.912", Q2: "1:15.415", Q3: "1:15.096"},
+				{Position: "20", DriverID: "bearman", Driver: "Oliver Bearman", ConstructorID: "haas", Constructor: "Haas F1 Team", Time: "-", Status: "Completed"},
+			},
+		},
+		{name: "no races", fixture: "no_races.json", session: "Race", wantErr: true},
+		{name: "empty results table", fixture: "empty_results.json", session: "Race", wantErr: true},
+		{name: "empty sprint table", fixture: "empty_results.json", session: "Sprint", wantErr: true},
+		{name: "no qualifying table", fixture: "no_qualifying.json", session: "Qualifying", wantErr: true},
+		{name: "practice", fixture: "race_dnf.json", session: "Practice 1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := ergastServer(t, tt.fixture)
+			got, err := src.SessionResults(context.Background(), "2025", "1", tt.session)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("want an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAliasedResultsExpire(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "ergast", "race_dnf.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Write(b)
+	}))
+	t.Cleanup(srv.Close)
+
+	src := NewErgast(srv.URL)
+	src.Cache = &Cache{Dir: t.TempDir()}
+	ctx := context.Background()
+
+	// The race is long over, so its results are final, but "current/last"
+	// will point to another race after the next weekend.
+	for _, season := range []string{"2025", "current"} {
+		round := "1"
+		if season == "current" {
+			round = "last"
+		}
+		if _, err := src.SessionResults(ctx, season, round, "Race"); err != nil {
+			t.Fatal(err)
+		}
+		url := fmt.Sprintf("%s/%s/%s/results.json", src.BaseURL, season, round)
+		old := time.Now().Add(-time.Hour)
+		if err := os.Chtimes(src.Cache.path(url), old, old); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := src.SessionResults(ctx, season, round, "Race"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{"/2025/1/results.json", "/current/last/results.json", "/current/last/results.json"}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requested %v, want %v", requests, want)
+	}
+}
+
+func TestDriverCareer(t *testing.T) {
+	totals := map[string]string{
+		"seasons.json":        "11",
+		"results.json":        "209",
+		"results/1.json":      "63",
+		"grid/1/results.json": "44",
+		"results/2.json":      "28",
+		"results/3.json":      "17",
+	}
+	var inFlight, most atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		if n > most.Load() {
+			most.Store(n)
+		}
+		time.Sleep(time.Millisecond)
+
+		total, ok := totals[strings.TrimPrefix(r.URL.Path, "/drivers/max_verstappen/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"MRData": {"total": %q}}`, total)
+	}))
+	t.Cleanup(srv.Close)
+
+	got, err := NewErgast(srv.URL).DriverCareer(context.Background(), "max_verstappen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := models.CareerStats{Seasons: 11, Starts: 209, Wins: 63, Poles: 44, Podiums: 108}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if n := most.Load(); n != 1 {
+		t.Errorf("%d requests at once, want them one after another", n)
+	}
+}
@@ -0,0 +1,382 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+const DefaultOpenF1Base = "https://api.openf1.org/v1"
+
+// LiveTiming serves timing for sessions a DataSource has no results for,
+// such as practice or a session still in progress. Sessions are identified
+// by kind ("Practice 1", "Race", ...) and scheduled start.
+type LiveTiming interface {
+	SessionTiming(ctx context.Context, kind string, start time.Time) (models.SessionTiming, error)
+}
+
+// OpenF1 talks to an OpenF1 compatible REST API. Point BaseURL at a local
+// server serving recorded responses to run it without the real service.
+// It keeps what it fetched of the sessions it timed, so polling a session
+// only asks for entries since the previous poll.
+type OpenF1 struct {
+	BaseURL string
+	Client  *http.Client
+
+	mu   sync.Mutex
+	live map[int]*liveState // by session key, of sessions timed so far
+}
+
+var _ LiveTiming = (*OpenF1)(nil)
+
+// NewOpenF1 returns a client for baseURL, or for DefaultOpenF1Base when
+// baseURL is empty.
+func NewOpenF1(baseURL string) *OpenF1 {
+	if baseURL == "" {
+		baseURL = DefaultOpenF1Base
+	}
+	return &OpenF1{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Client:  http.DefaultClient,
+	}
+}
+
+// get decodes endpoint?query into v. The query is passed through as is,
+// since OpenF1 filters use operators like date_start>=.
+func (o *OpenF1) get(ctx context.Context, endpoint, query string, v any) error {
+	u := o.BaseURL + "/" + endpoint
+	if query != "" {
+		u += "?" + query
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("openf1 error: status %d: %s", resp.StatusCode, string(b))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode %s: %w", u, err)
+	}
+	return nil
+}
+
+func sessionQuery(sessionKey int) string {
+	return fmt.Sprintf("session_key=%d", sessionKey)
+}
+
+func (o *OpenF1) Sessions(ctx context.Context, query string) ([]models.OpenF1Session, error) {
+	var out []models.OpenF1Session
+	return out, o.get(ctx, "sessions", query, &out)
+}
+
+func (o *OpenF1) Drivers(ctx context.Context, sessionKey int) ([]models.OpenF1Driver, error) {
+	var out []models.OpenF1Driver
+	return out, o.get(ctx, "drivers", sessionQuery(sessionKey), &out)
+}
+
+// liveOverlap is how far before the newest entry already fetched a poll
+// asks again, for entries published late, such as laps once they are
+// timed.
+const liveOverlap = 3 * time.Minute
+
+// since narrows a session query to entries whose field is at least
+// liveOverlap before newest; the zero time asks for every entry.
+func since(field string, newest time.Time) string {
+	if newest.IsZero() {
+		return ""
+	}
+	const layout = "2006-01-02T15:04:05"
+	return "&" + field + ">=" + url.QueryEscape(newest.Add(-liveOverlap).UTC().Format(layout))
+}
+
+// Laps returns the session's laps started since newest, see since.
+func (o *OpenF1) Laps(ctx context.Context, sessionKey int, newest time.Time) ([]models.OpenF1Lap, error) {
+	var out []models.OpenF1Lap
+	return out, o.get(ctx, "laps", sessionQuery(sessionKey)+since("date_start", newest), &out)
+}
+
+// Positions returns the session's position changes since newest, see
+// since.
+func (o *OpenF1) Positions(ctx context.Context, sessionKey int, newest time.Time) ([]models.OpenF1Position, error) {
+	var out []models.OpenF1Position
+	return out, o.get(ctx, "position", sessionQuery(sessionKey)+since("date", newest), &out)
+}
+
+// Intervals returns the session's gaps since newest, see since.
+func (o *OpenF1) Intervals(ctx context.Context, sessionKey int, newest time.Time) ([]models.OpenF1Interval, error) {
+	var out []models.OpenF1Interval
+	return out, o.get(ctx, "intervals", sessionQuery(sessionKey)+since("date", newest), &out)
+}
+
+func (o *OpenF1) Pits(ctx context.Context, sessionKey int) ([]models.OpenF1Pit, error) {
+	var out []models.OpenF1Pit
+	return out, o.get(ctx, "pit", sessionQuery(sessionKey), &out)
+}
+
+func (o *OpenF1) Stints(ctx context.Context, sessionKey int) ([]models.OpenF1Stint, error) {
+	var out []models.OpenF1Stint
+	return out, o.get(ctx, "stints", sessionQuery(sessionKey), &out)
+}
+
+// RaceControl returns the session's race control messages since newest,
+// see since.
+func (o *OpenF1) RaceControl(ctx context.Context, sessionKey int, newest time.Time) ([]models.OpenF1RaceControl, error) {
+	var out []models.OpenF1RaceControl
+	return out, o.get(ctx, "race_control", sessionQuery(sessionKey)+since("date", newest), &out)
+}
+
+// lapKey identifies a lap of a driver.
+type lapKey struct{ driver, lap int }
+
+// liveState is what the polls of a session have fetched of the endpoints
+// that grow through it: the latest entry per driver, or per lap, and the
+// date of the newest entry of each, which the next poll asks from.
+type liveState struct {
+	position map[int]models.OpenF1Position
+	gap      map[int]models.OpenF1Interval
+	laps     map[lapKey]models.OpenF1Lap
+	message  models.OpenF1RaceControl
+
+	positionsAt, intervalsAt, lapsAt, messagesAt time.Time
+}
+
+// liveState returns the state of the session, empty on its first poll.
+func (o *OpenF1) liveState(sessionKey int) *liveState {
+	if o.live == nil {
+		o.live = map[int]*liveState{}
+	}
+	st, ok := o.live[sessionKey]
+	if !ok {
+		st = &liveState{
+			position: map[int]models.OpenF1Position{},
+			gap:      map[int]models.OpenF1Interval{},
+			laps:     map[lapKey]models.OpenF1Lap{},
+		}
+		o.live[sessionKey] = st
+	}
+	return st
+}
+
+// merge adds a poll's entries, keeping the latest by date. OpenF1 sends
+// entries in date order, and entries fetched again in the overlap replace
+// themselves.
+func (st *liveState) merge(positions []models.OpenF1Position, intervals []models.OpenF1Interval, laps []models.OpenF1Lap, messages []models.OpenF1RaceControl) {
+	for _, p := range positions {
+		if !p.Date.Before(st.position[p.DriverNumber].Date) {
+			st.position[p.DriverNumber] = p
+		}
+		st.positionsAt = latest(st.positionsAt, p.Date)
+	}
+	for _, iv := range intervals {
+		if !iv.Date.Before(st.gap[iv.DriverNumber].Date) {
+			st.gap[iv.DriverNumber] = iv
+		}
+		st.intervalsAt = latest(st.intervalsAt, iv.Date)
+	}
+	for _, l := range laps {
+		st.laps[lapKey{l.DriverNumber, l.LapNumber}] = l
+		st.lapsAt = latest(st.lapsAt, l.DateStart)
+	}
+	for _, m := range messages {
+		if !m.Date.Before(st.message.Date) {
+			st.message = m
+		}
+		st.messagesAt = latest(st.messagesAt, m.Date)
+	}
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// SessionAt finds the session starting closest to start, within an hour.
+func (o *OpenF1) SessionAt(ctx context.Context, start time.Time) (models.OpenF1Session, error) {
+	const layout = "2006-01-02T15:04:05"
+	from := start.UTC().Add(-time.Hour).Format(layout)
+	to := start.UTC().Add(time.Hour).Format(layout)
+	query := fmt.Sprintf("date_start>=%s&date_start<=%s", url.QueryEscape(from), url.QueryEscape(to))
+
+	sessions, err := o.Sessions(ctx, query)
+	if err != nil {
+		return models.OpenF1Session{}, err
+	}
+	if len(sessions) == 0 {
+		return models.OpenF1Session{}, fmt.Errorf("no OpenF1 session starting around %s", start.Format("Jan 2 15:04"))
+	}
+
+	best := sessions[0]
+	for _, s := range sessions[1:] {
+		if absDuration(s.DateStart.Sub(start)) < absDuration(best.DateStart.Sub(start)) {
+			best = s
+		}
+	}
+	return best, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// SessionTiming classifies the session: by current position, with gaps to
+// the leader for races and best laps otherwise.
+func (o *OpenF1) SessionTiming(ctx context.Context, kind string, start time.Time) (models.SessionTiming, error) {
+	session, err := o.SessionAt(ctx, start)
+	if err != nil {
+		return models.SessionTiming{}, err
+	}
+	key := session.SessionKey
+
+	o.mu.Lock()
+	st := o.liveState(key)
+	positionsAt, intervalsAt, lapsAt, messagesAt := st.positionsAt, st.intervalsAt, st.lapsAt, st.messagesAt
+	o.mu.Unlock()
+
+	drivers, err := o.Drivers(ctx, key)
+	if err != nil {
+		return models.SessionTiming{}, err
+	}
+	positions, err := o.Positions(ctx, key, positionsAt)
+	if err != nil {
+		return models.SessionTiming{}, err
+	}
+	laps, err := o.Laps(ctx, key, lapsAt)
+	if err != nil {
+		return models.SessionTiming{}, err
+	}
+	stints, err := o.Stints(ctx, key)
+	if err != nil {
+		return models.SessionTiming{}, err
+	}
+	messages, err := o.RaceControl(ctx, key, messagesAt)
+	if err != nil {
+		return models.SessionTiming{}, err
+	}
+
+	racing := kind == "Race" || kind == "Sprint"
+	var intervals []models.OpenF1Interval
+	var pits []models.OpenF1Pit
+	if racing {
+		if intervals, err = o.Intervals(ctx, key, intervalsAt); err != nil {
+			return models.SessionTiming{}, err
+		}
+		if pits, err = o.Pits(ctx, key); err != nil {
+			return models.SessionTiming{}, err
+		}
+	}
+
+	o.mu.Lock()
+	st.merge(positions, intervals, laps, messages)
+	position := map[int]int{}
+	for n, p := range st.position {
+		position[n] = p.Position
+	}
+	gap := map[int]models.Gap{}
+	for n, iv := range st.gap {
+		gap[n] = iv.GapToLeader
+	}
+	best := map[int]float64{}
+	lapCount := map[int]int{}
+	for _, l := range st.laps {
+		lapCount[l.DriverNumber] = max(lapCount[l.DriverNumber], l.LapNumber)
+		if l.LapDuration == nil {
+			continue
+		}
+		if b, ok := best[l.DriverNumber]; !ok || *l.LapDuration < b {
+			best[l.DriverNumber] = *l.LapDuration
+		}
+	}
+	lastMessage := st.message.Message
+	o.mu.Unlock()
+
+	// Latest value per driver; OpenF1 sends entries in date order.
+	compound := map[int]string{}
+	for _, s := range stints {
+		compound[s.DriverNumber] = s.Compound
+	}
+	stops := map[int]int{}
+	for _, p := range pits {
+		stops[p.DriverNumber]++
+	}
+
+	if len(position) == 0 {
+		return models.SessionTiming{}, fmt.Errorf("no timing for %s yet", session.SessionName)
+	}
+
+	sort.Slice(drivers, func(i, j int) bool {
+		pi, pj := position[drivers[i].DriverNumber], position[drivers[j].DriverNumber]
+		if pi == 0 || pj == 0 {
+			return pj == 0 && pi != 0
+		}
+		return pi < pj
+	})
+
+	timing := models.SessionTiming{SessionName: session.SessionName}
+	for _, d := range drivers {
+		n := d.DriverNumber
+		pos := "-"
+		if p := position[n]; p > 0 {
+			pos = fmt.Sprint(p)
+		}
+
+		t := "-"
+		status := plural(lapCount[n], "lap")
+		if racing {
+			if g := gap[n]; pos == "1" {
+				t = "Leader"
+			} else if g != "" {
+				t = string(g)
+			}
+			status = plural(stops[n], "stop")
+			if c := compound[n]; c != "" {
+				status = c + " · " + status
+			}
+		} else if b, ok := best[n]; ok {
+			t = utils.FormatLapTime(time.Duration(math.Round(b * float64(time.Second))))
+		}
+
+		timing.Results = append(timing.Results, models.DriverResult{
+			Position:    pos,
+			Number:      fmt.Sprint(n),
+			Driver:      d.FullName,
+			Constructor: d.TeamName,
+			Time:        t,
+			Status:      status,
+		})
+	}
+	timing.LastMessage = lastMessage
+	return timing, nil
+}
+
+// plural is n of unit, e.g. "1 stop" or "2 stops".
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
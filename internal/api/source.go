@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// DataSource is everything the UI needs from an F1 data provider. The
+// Jolpica/Ergast client is the default implementation; mirrors, fixtures
+// or other providers can be plugged in by satisfying this interface.
+//
+// A season is a four digit year, or "" / "current" for the ongoing one.
+type DataSource interface {
+	Schedule(ctx context.Context, season string) ([]models.Race, error)
+	SessionResults(ctx context.Context, season, round, sessionType string) ([]models.DriverResult, error)
+	// DriverStandings returns the championship after round, or the latest
+	// standings when round is "".
+	DriverStandings(ctx context.Context, season, round string) (models.DriverStandingsTable, error)
+	ConstructorStandings(ctx context.Context, season, round string) (models.ConstructorStandingsTable, error)
+	// DriverLaps returns a driver's lap times and positions in a race.
+	DriverLaps(ctx context.Context, season, round, driverID string) ([]models.LapTime, error)
+	// FastestLap returns the fastest lap of a race.
+	FastestLap(ctx context.Context, season, round string) (models.LapTime, error)
+	// PitStops returns every pit stop of a race in stop order; Driver and
+	// Constructor are left for the caller to fill from the results.
+	PitStops(ctx context.Context, season, round string) ([]models.PitStop, error)
+	// Driver returns a driver's details.
+	Driver(ctx context.Context, driverID string) (models.Driver, error)
+	// DriverSeasonResults returns a driver's race results in a season.
+	DriverSeasonResults(ctx context.Context, season, driverID string) ([]models.SeasonResult, error)
+	// DriverCareer returns a driver's career totals.
+	DriverCareer(ctx context.Context, driverID string) (models.CareerStats, error)
+	// Constructor returns a constructor's details.
+	Constructor(ctx context.Context, constructorID string) (models.Constructor, error)
+	// ConstructorSeasonResults returns the race and sprint results of every
+	// car a constructor entered in a season.
+	ConstructorSeasonResults(ctx context.Context, season, constructorID string) ([]models.SeasonResult, error)
+	// RaceWinners returns the winner of every round of a season run so far.
+	RaceWinners(ctx context.Context, season string) ([]models.SeasonResult, error)
+	ResultsURL(season, round string) string
+}
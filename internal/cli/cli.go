@@ -0,0 +1,210 @@
+// Package cli parses the command line and runs the TUI or one of the
+// non-interactive subcommands.
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/config"
+	"github.com/kashifulhaque/f1-tui/internal/ui"
+)
+
+// command is a subcommand, run with the arguments following its name.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{"schedule", "list the rounds of a season, or the sessions of one", runSchedule},
+		{"next", "show the next session and how long until it starts", runNext},
+		{"results", "print the results of a race, sprint or qualifying", runResults},
+		{"standings", "print the drivers' or constructors' championship", runStandings},
+		{"widget", "one line next session summary for tmux, waybar or i3blocks", runWidget},
+		{"notify", "send desktop notifications before each session", runNotify},
+		{"export", "write the schedule as an iCalendar (.ics) file", runExport},
+		{"config", "print the effective settings and check the config file", runConfig},
+	}
+}
+
+// usageError is a bad command line; Main exits 2 for it.
+type usageError struct{ err error }
+
+func (e usageError) Error() string { return e.err.Error() }
+
+func usagef(format string, args ...any) error {
+	return usageError{fmt.Errorf(format, args...)}
+}
+
+// Main runs f1-tui with args, the command line without the program name,
+// and returns the exit code.
+func Main(args []string) int {
+	run := runTUI
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		run = nil
+		for _, c := range commands {
+			if c.name == args[0] {
+				run = c.run
+			}
+		}
+		if run == nil {
+			fmt.Fprintf(os.Stderr, "error: unknown command %q\n\n", args[0])
+			printCommands(os.Stderr)
+			return 2
+		}
+		args = args[1:]
+	}
+
+	err := run(args)
+	var ue usageError
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.As(err, &ue):
+		fmt.Fprintln(os.Stderr, "error:", err)
+		return 2
+	default:
+		fmt.Fprintln(os.Stderr, "error:", err)
+		return 1
+	}
+}
+
+func printCommands(w io.Writer) {
+	fmt.Fprintln(w, "Usage: f1-tui [flags]            start the TUI")
+	fmt.Fprintln(w, "       f1-tui <command> [flags]")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+}
+
+// newFlagSet is a flag set for the named command that returns parse
+// errors instead of exiting.
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("f1-tui "+name, flag.ContinueOnError)
+	if name == "" {
+		fs = flag.NewFlagSet("f1-tui", flag.ContinueOnError)
+		fs.Usage = func() {
+			printCommands(fs.Output())
+			fmt.Fprintln(fs.Output(), "\nFlags:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parse parses args into fs, treating stray positional arguments as a
+// usage error.
+func parse(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageError{err}
+	}
+	if fs.NArg() > 0 {
+		return usagef("unexpected argument %q", fs.Arg(0))
+	}
+	return nil
+}
+
+// dataFlags are the flags every command has for choosing what to load and
+// how.
+type dataFlags struct {
+	season  string
+	offline bool
+	noCache bool
+}
+
+func (f *dataFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.season, "season", "current", "season to show, e.g. 1988 (default: the ongoing season)")
+	fs.BoolVar(&f.offline, "offline", false, "serve only cached data, never touch the network")
+	fs.BoolVar(&f.noCache, "no-cache", false, "do not read or write the on-disk response cache")
+}
+
+// source validates the flags and builds the Ergast client they describe,
+// with the settings of the config file.
+func (f *dataFlags) source() (*api.Ergast, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return f.sourceFor(cfg.Config)
+}
+
+// sourceFor is source with the settings in cfg. The flags win over them:
+// --offline reads the cache even if the config file disables it.
+func (f *dataFlags) sourceFor(cfg config.Config) (*api.Ergast, error) {
+	if err := validateSeason(f.season); err != nil {
+		return nil, usageError{err}
+	}
+	if f.offline && f.noCache {
+		return nil, usagef("--offline and --no-cache cannot be combined")
+	}
+
+	src := api.NewErgast(cfg.Source.ErgastURL)
+	if !f.noCache && (cfg.Cache.Enabled || f.offline) {
+		cache, err := openCache(cfg.Cache.Dir)
+		if err != nil && f.offline {
+			return nil, fmt.Errorf("offline mode needs the cache: %w", err)
+		}
+		src.Cache = cache
+	}
+	src.Offline = f.offline
+	return src, nil
+}
+
+func validateSeason(s string) error {
+	if s == "" || s == "current" {
+		return nil
+	}
+	y, err := strconv.Atoi(s)
+	if err != nil || y < ui.FirstSeason || y > time.Now().Year() {
+		return fmt.Errorf("invalid season %q: want a year between %d and %d", s, ui.FirstSeason, time.Now().Year())
+	}
+	return nil
+}
+
+// loadedConfig is the settings file and where it was read from.
+type loadedConfig struct {
+	config.Config
+	path  string
+	found bool // the file exists; without it Config is config.Default
+}
+
+// loadConfig reads the settings file from its default location.
+func loadConfig() (loadedConfig, error) {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return loadedConfig{}, err
+	}
+	c, found, err := config.Load(path)
+	return loadedConfig{Config: c, path: path, found: found}, err
+}
+
+// openCache opens the response cache in dir, or in api.DefaultCacheDir
+// when dir is "".
+func openCache(dir string) (*api.Cache, error) {
+	if dir == "" {
+		d, err := api.DefaultCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = d
+	}
+	return api.NewCache(dir)
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kashifulhaque/f1-tui/internal/ui"
+)
+
+// runConfig checks the settings file and prints the settings in effect,
+// defaults included, as TOML.
+func runConfig(args []string) error {
+	fs := newFlagSet("config")
+	pathOnly := fs.Bool("path", false, "only print where the config file is read from")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if *pathOnly && cfg.path != "" {
+		fmt.Println(cfg.path)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	keys, keysErr := ui.NewKeyMap(cfg.Keys)
+	themeErr := ui.SetTheme(cfg.Theme)
+	if err := errors.Join(keysErr, themeErr); err != nil {
+		return fmt.Errorf("%s: %w", cfg.path, err)
+	}
+	cfg.Keys = keys.Bindings()
+
+	if cfg.found {
+		fmt.Printf("# %s\n", cfg.path)
+	} else {
+		fmt.Printf("# %s does not exist, these are the defaults\n", cfg.path)
+	}
+	return cfg.Encode(os.Stdout)
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/config"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// timeout bounds every command's requests, as the TUI does.
+const timeout = 10 * time.Second
+
+// registerTZ adds the --tz flag.
+func registerTZ(fs *flag.FlagSet) *string {
+	return fs.String("tz", "", "time zone for session times: local, track, utc or an IANA zone such as Europe/Berlin (default: the config file's timezone)")
+}
+
+// zone is where session times are shown: loc, or each circuit's own zone
+// when track is set.
+type zone struct {
+	loc   *time.Location
+	track bool
+}
+
+// of is the zone to show the sessions of r in.
+func (z zone) of(r models.Race) *time.Location {
+	if z.track {
+		return utils.CircuitLocation(r.Circuit)
+	}
+	return z.loc
+}
+
+// calendar lists every session of races, each GP in its zone.
+func (z zone) calendar(races []models.Race) []utils.CalendarSession {
+	var out []utils.CalendarSession
+	for _, r := range races {
+		out = append(out, utils.BuildCalendar([]models.Race{r}, z.of(r))...)
+	}
+	return out
+}
+
+// loadZone resolves a --tz value, falling back to the config file's
+// timezone and then to the local zone.
+func loadZone(name string, cfg config.Config) (zone, error) {
+	if name != "" {
+		cfg.Timezone = name
+		loc, track, err := cfg.Location()
+		if err != nil {
+			return zone{}, usagef("invalid --tz: %v", err)
+		}
+		return zone{loc: loc, track: track}, nil
+	}
+	// Validated by loadConfig.
+	loc, track, _ := cfg.Location()
+	if loc == nil {
+		loc = time.Local
+	}
+	return zone{loc: loc, track: track}, nil
+}
+
+// schedule fetches the races of season in round order, as the TUI lists
+// them.
+func schedule(src api.DataSource, season string) ([]models.Race, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	races, err := src.Schedule(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+	races = utils.SortRaces(races)
+	if len(races) == 0 {
+		return nil, fmt.Errorf("no races in season %s", season)
+	}
+	return races, nil
+}
+
+// sessionName normalises a --session value to the names used by
+// DataSource.SessionResults.
+func sessionName(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "race", "r":
+		return "Race", nil
+	case "qualifying", "quali", "q":
+		return "Qualifying", nil
+	case "sprint", "s":
+		return "Sprint", nil
+	}
+	return "", usagef("invalid --session %q: want Race, Qualifying or Sprint", s)
+}
+
+// formatTime renders a session time: readable in the plain
+// table, RFC 3339 for JSON and CSV.
+func (f *outputFlags) formatTime(t time.Time) string {
+	if f.json || f.csv {
+		return t.Format(time.RFC3339)
+	}
+	return t.Format("Mon Jan _2 15:04 MST")
+}
+
+// countdown is how long until s starts, or "live" while it runs.
+func countdown(s models.UISession, now time.Time) string {
+	if !now.Before(s.Start) {
+		return "live"
+	}
+	return utils.FormatCountdown(s.Start.Sub(now))
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/config"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+func TestLoadZone(t *testing.T) {
+	tokyo := config.Default()
+	tokyo.Timezone = "Asia/Tokyo"
+	track := config.Default()
+	track.Timezone = "track"
+
+	tests := []struct {
+		tz      string
+		cfg     config.Config
+		want    string // zone name, or "track"
+		wantErr bool
+	}{
+		{"", config.Default(), "Local", false},
+		{"", tokyo, "Asia/Tokyo", false},
+		{"", track, "track", false},
+		{"UTC", tokyo, "UTC", false},
+		{"Europe/Berlin", track, "Europe/Berlin", false},
+		{"track", tokyo, "track", false},
+		{"Mars/Base", tokyo, "", true},
+	}
+	for _, tt := range tests {
+		z, err := loadZone(tt.tz, tt.cfg)
+		if tt.wantErr {
+			var ue usageError
+			if !errors.As(err, &ue) {
+				t.Errorf("--tz %q: want a usage error, got %v", tt.tz, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("--tz %q with %q: %v", tt.tz, tt.cfg.Timezone, err)
+			continue
+		}
+		got := "track"
+		if !z.track {
+			got = z.loc.String()
+		}
+		if got != tt.want {
+			t.Errorf("--tz %q with %q: got %s, want %s", tt.tz, tt.cfg.Timezone, got, tt.want)
+		}
+	}
+}
+
+func TestZoneCalendarTrack(t *testing.T) {
+	r := weekend()
+	r.Circuit.CircuitID = "red_bull_ring"
+	cal := zone{track: true}.calendar([]models.Race{r})
+	if len(cal) != 2 {
+		t.Fatalf("got %d sessions, want 2", len(cal))
+	}
+	race := cal[1].Session
+	if race.Start.Location().String() != "Europe/Vienna" || race.Start.Hour() != 15 {
+		t.Errorf("race starts %s, want 15:00 Europe/Vienna", race.Start)
+	}
+	if !race.Start.Equal(time.Date(2025, 6, 29, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("race starts at %s, want 13:00 UTC", race.Start.UTC())
+	}
+}
+
+// scheduleSource serves races as every season's schedule.
+type scheduleSource struct {
+	api.DataSource
+	races []models.Race
+}
+
+func (s scheduleSource) Schedule(ctx context.Context, season string) ([]models.Race, error) {
+	return s.races, nil
+}
+
+func TestScheduleInRoundOrder(t *testing.T) {
+	gp := func(round, name string) models.Race {
+		r := models.Race{Season: "2025", Round: round, RaceName: name}
+		if name != "" {
+			r.Circuit.CircuitName = name + " Circuit"
+		}
+		return r
+	}
+	src := scheduleSource{races: []models.Race{gp("10", "Canadian Grand Prix"), gp("2", "Chinese Grand Prix"), gp("11", ""), gp("1", "Australian Grand Prix")}}
+
+	races, err := schedule(src, "2025")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rounds []string
+	for _, r := range races {
+		rounds = append(rounds, r.Round)
+	}
+	if got := strings.Join(rounds, " "); got != "1 2 10" {
+		t.Errorf("rounds %s, want 1 2 10", got)
+	}
+
+	if _, err := schedule(scheduleSource{races: []models.Race{gp("1", "")}}, "2025"); err == nil {
+		t.Error("a schedule of rounds to be announced: want an error")
+	}
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/ical"
+)
+
+// runExport writes the sessions of a season, or of one round, to an
+// iCalendar file.
+func runExport(args []string) error {
+	var data dataFlags
+	fs := newFlagSet("export")
+	data.register(fs)
+	round := fs.String("round", "", "export only this round")
+	out := fs.String("o", "", "output file, - for stdout (default f1-<season>[-round-<n>].ics)")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	src, err := data.source()
+	if err != nil {
+		return err
+	}
+
+	races, err := schedule(src, data.season)
+	if err != nil {
+		return err
+	}
+	season := races[0].Season
+
+	events, err := ical.SeasonEvents(races, *round, src.ResultsURL)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("no round %s in season %s", *round, season)
+	}
+
+	name := "F1 " + season
+	switch *out {
+	case "-":
+		return ical.Write(os.Stdout, name, events, time.Now())
+	case "":
+		*out = ical.FileName(season, *round)
+	}
+	if err := ical.WriteFile(*out, name, events); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "wrote %d sessions to %s\n", len(events), *out)
+	return nil
+}
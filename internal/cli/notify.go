@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+const (
+	// notifyPoll is how often the daemon checks for due alerts. Polling
+	// the wall clock, rather than sleeping until the next alert, copes
+	// with suspend and resume.
+	notifyPoll = 30 * time.Second
+	// notifyRefresh is how often the schedule is fetched again, to pick
+	// up rescheduled sessions and the next season.
+	notifyRefresh = 6 * time.Hour
+)
+
+// leadsFlag is a comma separated list of durations, e.g. "1h,10m".
+type leadsFlag []time.Duration
+
+func (f *leadsFlag) String() string {
+	var parts []string
+	for _, d := range *f {
+		parts = append(parts, formatLead(d))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *leadsFlag) Set(s string) error {
+	var leads []time.Duration
+	for _, p := range strings.Split(s, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(p))
+		if err != nil {
+			return err
+		}
+		if d <= 0 {
+			return fmt.Errorf("lead time %s is not positive", p)
+		}
+		leads = append(leads, d)
+	}
+	*f = leads
+	return nil
+}
+
+// formatLead renders d without zero units: "1h", "10m", "1h30m".
+func formatLead(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// alert is one notification due At, Lead before a session starts.
+type alert struct {
+	at      time.Time
+	lead    time.Duration
+	session utils.CalendarSession
+}
+
+func (a alert) key() string {
+	r := a.session.Race
+	return fmt.Sprintf("%s/%s/%s/%s", r.Season, r.Round, a.session.Session.Kind, a.lead)
+}
+
+func (a alert) title() string {
+	return fmt.Sprintf("%s starts in %s", a.session.Session.Kind, formatLead(a.lead))
+}
+
+func (a alert) body() string {
+	r, s := a.session.Race, a.session.Session
+	return fmt.Sprintf("%s, %s\n%s", r.RaceName, r.Circuit.CircuitName, s.Start.Format("Mon Jan _2 15:04 MST"))
+}
+
+// planAlerts lists the alerts for every session of calendar that has not
+// started by now, earliest first.
+func planAlerts(calendar []utils.CalendarSession, leads []time.Duration, now time.Time) []alert {
+	var alerts []alert
+	for _, c := range calendar {
+		if !now.Before(c.Session.Start) {
+			continue
+		}
+		for _, lead := range leads {
+			alerts = append(alerts, alert{at: c.Session.Start.Add(-lead), lead: lead, session: c})
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].at.Before(alerts[j].at) })
+	return alerts
+}
+
+// runNotify sends a desktop notification ahead of every session, or with
+// --dry-run lists the notifications it would send.
+func runNotify(args []string) error {
+	var data dataFlags
+	fs := newFlagSet("notify")
+	data.register(fs)
+	tz := registerTZ(fs)
+	leads := leadsFlag{time.Hour, 10 * time.Minute}
+	fs.Var(&leads, "lead", "comma separated times before each session to notify at")
+	dryRun := fs.Bool("dry-run", false, "print the planned notifications and exit")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	z, err := loadZone(*tz, cfg.Config)
+	if err != nil {
+		return err
+	}
+	src, err := data.sourceFor(cfg.Config)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		races, err := schedule(src, data.season)
+		if err != nil {
+			return err
+		}
+		out := outputFlags{}
+		t := table{columns: []column{
+			{"Notify At", "at"},
+			{"Lead", "lead"},
+			{"Session", "session"},
+			{"Grand Prix", "raceName"},
+		}}
+		for _, a := range planAlerts(z.calendar(races), leads, time.Now()) {
+			t.rows = append(t.rows, []string{out.formatTime(a.at), formatLead(a.lead), a.session.Session.Kind, a.session.Race.RaceName})
+		}
+		return out.print(os.Stdout, t)
+	}
+
+	if _, err := exec.LookPath("notify-send"); err != nil {
+		return errors.New("notify-send not found: install libnotify, or use --dry-run")
+	}
+	return notifyLoop(src, data.season, z, leads)
+}
+
+// notifyLoop runs until killed, sending each alert once when it falls due.
+func notifyLoop(src api.DataSource, season string, z zone, leads []time.Duration) error {
+	n := notifier{
+		fetch: func() ([]models.Race, error) { return schedule(src, season) },
+		zone:  z,
+		leads: leads,
+		sent:  map[string]bool{},
+	}
+	for {
+		for _, a := range n.due(time.Now()) {
+			if err := notifySend(a.title(), a.body()); err != nil {
+				fmt.Fprintln(os.Stderr, "warning: notify:", err)
+			}
+		}
+		time.Sleep(notifyPoll)
+	}
+}
+
+// notifier tracks which alerts are due and which were sent. Alerts
+// already due when the first schedule arrives are skipped rather than sent
+// in a burst.
+type notifier struct {
+	fetch func() ([]models.Race, error)
+	zone  zone
+	leads []time.Duration
+
+	sent    map[string]bool
+	alerts  []alert
+	fetched time.Time // of the last schedule planned, zero until one was
+}
+
+// due returns the alerts to send at now, fetching the schedule again when
+// it is older than notifyRefresh.
+func (n *notifier) due(now time.Time) []alert {
+	primed := !n.fetched.IsZero()
+	if now.Sub(n.fetched) >= notifyRefresh {
+		races, err := n.fetch()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "warning: schedule:", err)
+		} else {
+			n.alerts = planAlerts(n.zone.calendar(races), n.leads, now)
+			n.fetched = now
+		}
+	}
+
+	var due []alert
+	for _, a := range n.alerts {
+		if a.at.After(now) || !now.Before(a.session.Session.Start) || n.sent[a.key()] {
+			continue
+		}
+		n.sent[a.key()] = true
+		due = append(due, a)
+	}
+	if !primed {
+		return nil
+	}
+	return due
+}
+
+// notifySend shows a notification through the freedesktop notification
+// service.
+func notifySend(title, body string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return exec.CommandContext(ctx, "notify-send", "--app-name=f1-tui", title, body).Run()
+}
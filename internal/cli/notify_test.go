@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// weekend is a GP with qualifying on Saturday 2025-06-28 14:00 UTC and the
+// race on Sunday 13:00 UTC.
+func weekend() models.Race {
+	r := models.Race{Season: "2025", Round: "11", RaceName: "Austrian Grand Prix", Date: "2025-06-29", Time: "13:00:00Z"}
+	r.Circuit.CircuitName = "Red Bull Ring"
+	r.Qualifying = &models.Session{Date: "2025-06-28", Time: "14:00:00Z"}
+	return r
+}
+
+func at(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestPlanAlerts(t *testing.T) {
+	cal := utils.BuildCalendar([]models.Race{weekend()}, time.UTC)
+	leads := []time.Duration{time.Hour, 10 * time.Minute}
+
+	// Qualifying has started, so only the race is planned.
+	alerts := planAlerts(cal, leads, at("2025-06-28T14:05:00Z"))
+	want := []struct {
+		at   string
+		lead time.Duration
+		kind string
+	}{
+		{"2025-06-29T12:00:00Z", time.Hour, "Race"},
+		{"2025-06-29T12:50:00Z", 10 * time.Minute, "Race"},
+	}
+	if len(alerts) != len(want) {
+		t.Fatalf("got %d alerts, want %d: %+v", len(alerts), len(want), alerts)
+	}
+	for i, w := range want {
+		a := alerts[i]
+		if !a.at.Equal(at(w.at)) || a.lead != w.lead || a.session.Session.Kind != w.kind {
+			t.Errorf("alert %d: %s %s %s, want %s %s %s", i, a.at, a.lead, a.session.Session.Kind, w.at, w.lead, w.kind)
+		}
+	}
+
+	if n := len(planAlerts(cal, leads, at("2025-06-28T10:00:00Z"))); n != 4 {
+		t.Errorf("before qualifying: %d alerts, want 4", n)
+	}
+}
+
+func TestNotifierSkipsAlertsDueAtStartup(t *testing.T) {
+	fail := true
+	n := notifier{
+		fetch: func() ([]models.Race, error) {
+			if fail {
+				return nil, errors.New("network is down")
+			}
+			return []models.Race{weekend()}, nil
+		},
+		zone:  zone{loc: time.UTC},
+		leads: []time.Duration{time.Hour, 10 * time.Minute},
+		sent:  map[string]bool{},
+	}
+
+	// Started 20 minutes before qualifying without a network: the 1h
+	// alert is already due.
+	if due := n.due(at("2025-06-28T13:40:00Z")); len(due) != 0 {
+		t.Fatalf("sent %d alerts without a schedule", len(due))
+	}
+	fail = false
+	if due := n.due(at("2025-06-28T13:40:30Z")); len(due) != 0 {
+		t.Fatalf("first schedule sent a burst of %d alerts due at startup", len(due))
+	}
+	if due := n.due(at("2025-06-28T13:45:00Z")); len(due) != 0 {
+		t.Fatalf("sent %d alerts between leads", len(due))
+	}
+
+	due := n.due(at("2025-06-28T13:50:10Z"))
+	if len(due) != 1 || due[0].lead != 10*time.Minute || due[0].session.Session.Kind != "Qualifying" {
+		t.Fatalf("at the 10m lead: %+v", due)
+	}
+	if due := n.due(at("2025-06-28T13:50:40Z")); len(due) != 0 {
+		t.Errorf("resent %d alerts", len(due))
+	}
+
+	due = n.due(at("2025-06-29T12:00:20Z"))
+	if len(due) != 1 || due[0].lead != time.Hour || due[0].session.Session.Kind != "Race" {
+		t.Errorf("at the race's 1h lead: %+v", due)
+	}
+}
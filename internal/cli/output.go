@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// column is one column of a command's output: Title heads the plain table
+// and CSV, Key names the field in JSON.
+type column struct {
+	title string
+	key   string
+}
+
+// table is the output of a command, printed by outputFlags.print.
+type table struct {
+	columns []column
+	rows    [][]string
+	single  bool // JSON is one object rather than an array
+}
+
+// outputFlags choose how a command prints its table.
+type outputFlags struct {
+	json bool
+	csv  bool
+}
+
+func (f *outputFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&f.json, "json", false, "print JSON")
+	fs.BoolVar(&f.csv, "csv", false, "print CSV")
+}
+
+func (f *outputFlags) validate() error {
+	if f.json && f.csv {
+		return usagef("--json and --csv cannot be combined")
+	}
+	return nil
+}
+
+func (f *outputFlags) print(w io.Writer, t table) error {
+	switch {
+	case f.json:
+		return printJSON(w, t)
+	case f.csv:
+		return printCSV(w, t)
+	default:
+		return printPlain(w, t)
+	}
+}
+
+func printPlain(w io.Writer, t table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	titles := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		titles[i] = strings.ToUpper(c.title)
+	}
+	fmt.Fprintln(tw, strings.Join(titles, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func printCSV(w io.Writer, t table) error {
+	cw := csv.NewWriter(w)
+	titles := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		titles[i] = c.title
+	}
+	cw.Write(titles)
+	cw.WriteAll(t.rows)
+	return cw.Error()
+}
+
+// printJSON writes each row as an object keyed by column, in column order.
+func printJSON(w io.Writer, t table) error {
+	objects := make([]json.RawMessage, len(t.rows))
+	for i, row := range t.rows {
+		var b bytes.Buffer
+		b.WriteByte('{')
+		for j, c := range t.columns {
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			k, _ := json.Marshal(c.key)
+			v, _ := json.Marshal(row[j])
+			b.Write(k)
+			b.WriteByte(':')
+			b.Write(v)
+		}
+		b.WriteByte('}')
+		objects[i] = b.Bytes()
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if t.single {
+		if len(objects) == 0 {
+			return enc.Encode(nil)
+		}
+		return enc.Encode(objects[0])
+	}
+	return enc.Encode(objects)
+}
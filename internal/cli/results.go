@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// runResults prints the classification of a race, sprint or qualifying.
+func runResults(args []string) error {
+	var data dataFlags
+	var out outputFlags
+	fs := newFlagSet("results")
+	data.register(fs)
+	out.register(fs)
+	round := fs.String("round", "last", "round of the season, or last for the latest")
+	session := fs.String("session", "Race", "Race, Qualifying or Sprint")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+	kind, err := sessionName(*session)
+	if err != nil {
+		return err
+	}
+	src, err := data.source()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	results, err := src.SessionResults(ctx, data.season, *round, kind)
+	if err != nil {
+		return err
+	}
+
+	if kind == "Qualifying" {
+		return out.print(os.Stdout, qualifyingTable(results))
+	}
+	return out.print(os.Stdout, raceTable(results))
+}
+
+func qualifyingTable(results []models.DriverResult) table {
+	t := table{columns: []column{
+		{"Pos", "position"},
+		{"Driver", "driver"},
+		{"Team", "team"},
+		{"Q1", "q1"},
+		{"Q2", "q2"},
+		{"Q3", "q3"},
+	}}
+	for _, r := range results {
+		t.rows = append(t.rows, []string{r.Position, r.Driver, r.Constructor, r.Q1, r.Q2, r.Q3})
+	}
+	return t
+}
+
+func raceTable(results []models.DriverResult) table {
+	t := table{columns: []column{
+		{"Pos", "position"},
+		{"No", "number"},
+		{"Driver", "driver"},
+		{"Team", "team"},
+		{"Grid", "grid"},
+		{"Laps", "laps"},
+		{"Time", "time"},
+		{"Status", "status"},
+		{"Points", "points"},
+	}}
+	for _, r := range results {
+		t.rows = append(t.rows, []string{r.Position, r.Number, r.Driver, r.Constructor, r.Grid, r.Laps, r.Time, r.Status, r.Points})
+	}
+	return t
+}
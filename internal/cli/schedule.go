@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// runSchedule lists the rounds of a season, or the sessions of one round.
+func runSchedule(args []string) error {
+	var data dataFlags
+	var out outputFlags
+	fs := newFlagSet("schedule")
+	data.register(fs)
+	out.register(fs)
+	tz := registerTZ(fs)
+	round := fs.String("round", "", "list the sessions of this round")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	z, err := loadZone(*tz, cfg.Config)
+	if err != nil {
+		return err
+	}
+	src, err := data.sourceFor(cfg.Config)
+	if err != nil {
+		return err
+	}
+
+	races, err := schedule(src, data.season)
+	if err != nil {
+		return err
+	}
+
+	if *round != "" {
+		t := table{columns: []column{
+			{"Session", "session"},
+			{"Start", "start"},
+			{"End", "end"},
+		}}
+		for _, c := range z.calendar(races) {
+			if c.Race.Round != *round {
+				continue
+			}
+			t.rows = append(t.rows, []string{c.Session.Kind, out.formatTime(c.Session.Start), out.formatTime(c.Session.End)})
+		}
+		if len(t.rows) == 0 {
+			return usagef("no round %s in season %s", *round, races[0].Season)
+		}
+		return out.print(os.Stdout, t)
+	}
+
+	t := table{columns: []column{
+		{"Round", "round"},
+		{"Grand Prix", "raceName"},
+		{"Circuit", "circuit"},
+		{"Country", "country"},
+		{"Sprint", "sprint"},
+		{"Race Start", "raceStart"},
+	}}
+	for _, r := range races {
+		_, race, err := utils.BuildUISessions(r, r.Season, r.Round, z.of(r), src.ResultsURL)
+		start := ""
+		if err == nil {
+			start = out.formatTime(race.Start)
+		}
+		sprint := ""
+		if r.Sprint != nil {
+			sprint = "yes"
+		}
+		t.rows = append(t.rows, []string{r.Round, r.RaceName, r.Circuit.CircuitName, r.Circuit.Location.Country, sprint, start})
+	}
+	return out.print(os.Stdout, t)
+}
+
+// runNext prints the session running now, or the next one to start.
+func runNext(args []string) error {
+	var data dataFlags
+	var out outputFlags
+	fs := newFlagSet("next")
+	data.register(fs)
+	out.register(fs)
+	tz := registerTZ(fs)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	z, err := loadZone(*tz, cfg.Config)
+	if err != nil {
+		return err
+	}
+	src, err := data.sourceFor(cfg.Config)
+	if err != nil {
+		return err
+	}
+
+	races, err := schedule(src, data.season)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	next, ok := utils.NextSession(z.calendar(races), now)
+	if !ok {
+		return fmt.Errorf("no sessions left in season %s", races[0].Season)
+	}
+
+	return out.print(os.Stdout, table{
+		columns: []column{
+			{"Round", "round"},
+			{"Grand Prix", "raceName"},
+			{"Session", "session"},
+			{"Start", "start"},
+			{"In", "countdown"},
+		},
+		rows: [][]string{{
+			next.Race.Round,
+			next.Race.RaceName,
+			next.Session.Kind,
+			out.formatTime(next.Session.Start),
+			countdown(next.Session, now),
+		}},
+		single: true,
+	})
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"os"
+)
+
+// runStandings prints the drivers' or constructors' championship.
+func runStandings(args []string) error {
+	var data dataFlags
+	var out outputFlags
+	fs := newFlagSet("standings")
+	data.register(fs)
+	out.register(fs)
+	round := fs.String("round", "", "standings after this round (default: latest)")
+	constructors := fs.Bool("constructors", false, "print the constructors' championship")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+	src, err := data.source()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if *constructors {
+		st, err := src.ConstructorStandings(ctx, data.season, *round)
+		if err != nil {
+			return err
+		}
+		t := table{columns: []column{
+			{"Pos", "position"},
+			{"Team", "team"},
+			{"Nationality", "nationality"},
+			{"Wins", "wins"},
+			{"Points", "points"},
+		}}
+		for _, s := range st.Standings {
+			t.rows = append(t.rows, []string{s.Position, s.Constructor, s.Nationality, s.Wins, s.Points})
+		}
+		return out.print(os.Stdout, t)
+	}
+
+	st, err := src.DriverStandings(ctx, data.season, *round)
+	if err != nil {
+		return err
+	}
+	t := table{columns: []column{
+		{"Pos", "position"},
+		{"Driver", "driver"},
+		{"Nationality", "nationality"},
+		{"Team", "team"},
+		{"Wins", "wins"},
+		{"Points", "points"},
+	}}
+	for _, s := range st.Standings {
+		t.rows = append(t.rows, []string{s.Position, s.Driver, s.Nationality, s.Constructor, s.Wins, s.Points})
+	}
+	return out.print(os.Stdout, t)
+}
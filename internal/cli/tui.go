@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/ui"
+)
+
+// runTUI starts the interactive UI.
+func runTUI(args []string) error {
+	var data dataFlags
+	fs := newFlagSet("")
+	data.register(fs)
+	tz := fs.String("tz", "", "time zone to start session times in: local, track, utc or an IANA zone such as Europe/Berlin (z cycles zones; default from the config file)")
+	openf1URL := fs.String("openf1-url", api.DefaultOpenF1Base, "OpenF1 compatible API for practice and live timing (empty disables it; default from the config file)")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	z, err := loadZone(*tz, cfg.Config)
+	if err != nil {
+		return err
+	}
+	// The TUI starts on local time unless given another zone.
+	loc := z.loc
+	if loc == time.Local {
+		loc = nil
+	}
+	keys, err := ui.NewKeyMap(cfg.Keys)
+	if err != nil {
+		return fmt.Errorf("%s: %w", cfg.path, err)
+	}
+	if err := ui.SetTheme(cfg.Theme); err != nil {
+		return fmt.Errorf("%s: %w", cfg.path, err)
+	}
+
+	if !isSet(fs, "openf1-url") {
+		*openf1URL = cfg.Source.OpenF1URL
+	}
+
+	src, err := data.sourceFor(cfg.Config)
+	if err != nil {
+		return err
+	}
+
+	var timing api.LiveTiming
+	if *openf1URL != "" && !data.offline {
+		timing = api.NewOpenF1(*openf1URL)
+	}
+
+	p := tea.NewProgram(ui.InitialModel(ui.Options{
+		Source:          src,
+		Timing:          timing,
+		Season:          data.season,
+		Offline:         src.Offline,
+		Location:        loc,
+		TrackTime:       z.track,
+		View:            cfg.DefaultView,
+		FavouriteDriver: cfg.Favourites.Driver,
+		FavouriteTeam:   cfg.Favourites.Team,
+		Keys:            &keys,
+	}), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		return fmt.Errorf("tui: %w", err)
+	}
+	return nil
+}
+
+// isSet reports whether the flag name was given on the command line.
+func isSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// widgetFormats are the status bars runWidget can print for.
+var widgetFormats = []string{"plain", "tmux", "waybar", "i3blocks"}
+
+// widgetState is the next session summarised for a status bar.
+type widgetState struct {
+	text    string // "🇯🇵 Japanese GP · Qualifying in 1d 04:13:22"
+	short   string // "Qualifying 1d 04:13:22"
+	tooltip string
+	class   string // "live", "upcoming", "none" or "error"
+}
+
+// runWidget prints a one line summary of the next session for status bars,
+// once or every --watch interval.
+func runWidget(args []string) error {
+	var data dataFlags
+	fs := newFlagSet("widget")
+	data.register(fs)
+	tz := registerTZ(fs)
+	format := fs.String("format", "plain", "output for "+strings.Join(widgetFormats, ", "))
+	watch := fs.Duration("watch", 0, "re-print on this interval, e.g. 30s (default: print once)")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if !validWidgetFormat(*format) {
+		return usagef("invalid --format %q: want one of %s", *format, strings.Join(widgetFormats, ", "))
+	}
+	if *watch < 0 {
+		return usagef("invalid --watch %s", *watch)
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	z, err := loadZone(*tz, cfg.Config)
+	if err != nil {
+		return err
+	}
+	src, err := data.sourceFor(cfg.Config)
+	if err != nil {
+		return err
+	}
+
+	if *watch == 0 {
+		st, err := widgetNext(src, data.season, z, time.Now())
+		if err != nil {
+			return err
+		}
+		return printWidget(os.Stdout, *format, st, false)
+	}
+
+	// Status bars keep a watching widget running, so a failed fetch is
+	// shown in the bar instead of ending the process. The cache keeps the
+	// repeated schedule fetches cheap.
+	for {
+		st, err := widgetNext(src, data.season, z, time.Now())
+		if err != nil {
+			st = widgetState{text: "F1: " + err.Error(), short: "F1: error", tooltip: err.Error(), class: "error"}
+		}
+		if err := printWidget(os.Stdout, *format, st, true); err != nil {
+			return err
+		}
+		time.Sleep(*watch)
+	}
+}
+
+func validWidgetFormat(f string) bool {
+	for _, w := range widgetFormats {
+		if f == w {
+			return true
+		}
+	}
+	return false
+}
+
+// widgetNext summarises the session of season running at now, or the next
+// one to start.
+func widgetNext(src api.DataSource, season string, z zone, now time.Time) (widgetState, error) {
+	races, err := schedule(src, season)
+	if err != nil {
+		return widgetState{}, err
+	}
+	next, ok := utils.NextSession(z.calendar(races), now)
+	if !ok {
+		return widgetState{text: "F1: season over", short: "F1", tooltip: "No sessions left in " + races[0].Season, class: "none"}, nil
+	}
+
+	r, s := next.Race, next.Session
+	gp := strings.Replace(r.RaceName, "Grand Prix", "GP", 1)
+	if code := utils.CountryNameToCode(r.Circuit.Location.Country, r.RaceName, r.Circuit.CircuitName); code != "" {
+		gp = utils.CountryCodeToFlag(code) + " " + gp
+	}
+
+	st := widgetState{
+		tooltip: fmt.Sprintf("%s\n%s\n%s: %s", r.RaceName, r.Circuit.CircuitName, s.Kind, s.Start.Format("Mon Jan _2 15:04 MST")),
+		class:   "upcoming",
+	}
+	if now.Before(s.Start) {
+		in := utils.FormatCountdown(s.Start.Sub(now))
+		st.text = fmt.Sprintf("%s · %s in %s", gp, s.Kind, in)
+		st.short = fmt.Sprintf("%s %s", s.Kind, in)
+	} else {
+		st.text = fmt.Sprintf("%s · %s LIVE", gp, s.Kind)
+		st.short = s.Kind + " LIVE"
+		st.class = "live"
+	}
+	return st, nil
+}
+
+// printWidget writes st in format, as one update of a --watch loop when
+// watching:
+//   - plain: the summary line
+//   - tmux: the summary, highlighted while live
+//   - waybar: one JSON object per line, for a custom module
+//   - i3blocks: full text, short text and colour lines, or only the full
+//     text when watching, as a persistent block reads each line as one
+func printWidget(w io.Writer, format string, st widgetState, watching bool) error {
+	if format == "i3blocks" && watching {
+		_, err := fmt.Fprintln(w, st.text)
+		return err
+	}
+
+	var err error
+	switch format {
+	case "tmux":
+		text := strings.ReplaceAll(st.text, "#", "##")
+		if st.class == "live" {
+			text = "#[fg=green,bold]" + text + "#[default]"
+		}
+		_, err = fmt.Fprintln(w, text)
+	case "waybar":
+		err = json.NewEncoder(w).Encode(struct {
+			Text    string `json:"text"`
+			Tooltip string `json:"tooltip"`
+			Class   string `json:"class"`
+		}{st.text, st.tooltip, st.class})
+	case "i3blocks":
+		color := ""
+		switch st.class {
+		case "live":
+			color = "#00FF00"
+		case "error":
+			color = "#FF0000"
+		}
+		_, err = fmt.Fprintf(w, "%s\n%s\n%s\n", st.text, st.short, color)
+	default:
+		_, err = fmt.Fprintln(w, st.text)
+	}
+	return err
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrintWidgetI3blocks(t *testing.T) {
+	st := widgetState{text: "🇯🇵 Japanese GP · Qualifying LIVE", short: "Qualifying LIVE", class: "live"}
+	tests := []struct {
+		watching bool
+		want     string
+	}{
+		{false, "🇯🇵 Japanese GP · Qualifying LIVE\nQualifying LIVE\n#00FF00\n"},
+		// A persistent block takes every line as a new full text.
+		{true, "🇯🇵 Japanese GP · Qualifying LIVE\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := printWidget(&buf, "i3blocks", st, tt.watching); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("watching %v: got %q, want %q", tt.watching, got, tt.want)
+		}
+	}
+}
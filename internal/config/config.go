@@ -0,0 +1,175 @@
+// Package config loads the user's settings file,
+// $XDG_CONFIG_HOME/f1-tui/config.toml.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+)
+
+// Views are the values of DefaultView.
+var Views = []string{"schedule", "calendar", "drivers", "constructors"}
+
+// Config is the settings file. Settings missing from the file keep the
+// values of Default.
+type Config struct {
+	// Timezone is "local", "track", "utc" or an IANA zone such as
+	// "Europe/Berlin".
+	Timezone string `toml:"timezone"`
+	// Theme names a colour theme of the TUI.
+	Theme string `toml:"theme"`
+	// DefaultView is the tab shown at startup, one of Views.
+	DefaultView string `toml:"default_view"`
+
+	Favourites Favourites `toml:"favourites"`
+	Source     Source     `toml:"source"`
+	Cache      Cache      `toml:"cache"`
+
+	// Keys maps an action name, such as "next" or "refresh", to the keys
+	// that trigger it, replacing its default keys.
+	Keys map[string][]string `toml:"keys"`
+}
+
+// Favourites are highlighted wherever they appear, by Ergast ID, e.g.
+// "max_verstappen" and "red_bull".
+type Favourites struct {
+	Driver string `toml:"driver"`
+	Team   string `toml:"team"`
+}
+
+// Source are the data providers' base URLs.
+type Source struct {
+	ErgastURL string `toml:"ergast_url"`
+	// OpenF1URL is the live timing server; "" disables live timing.
+	OpenF1URL string `toml:"openf1_url"`
+}
+
+// Cache configures the on-disk response cache.
+type Cache struct {
+	Enabled bool   `toml:"enabled"`
+	Dir     string `toml:"dir"` // "" for api.DefaultCacheDir
+}
+
+// Default is the configuration used when there is no settings file.
+func Default() Config {
+	return Config{
+		Timezone:    "local",
+		Theme:       "default",
+		DefaultView: "schedule",
+		Source: Source{
+			ErgastURL: api.DefaultErgastBase,
+			OpenF1URL: api.DefaultOpenF1Base,
+		},
+		Cache: Cache{Enabled: true},
+	}
+}
+
+// DefaultPath is where the settings file lives, under the user's config
+// directory.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "f1-tui", "config.toml"), nil
+}
+
+// Load reads the settings file at path over Default. A missing file is not
+// an error and yields Default; unknown or invalid settings are. found
+// reports whether the file exists.
+func Load(path string) (c Config, found bool, err error) {
+	c = Default()
+	md, err := toml.DecodeFile(path, &c)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Default(), false, nil
+	}
+	if err != nil {
+		return Config{}, true, fmt.Errorf("%s: %w", path, err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		var keys []string
+		for _, k := range undecoded {
+			keys = append(keys, k.String())
+		}
+		return Config{}, true, fmt.Errorf("%s: unknown settings: %s", path, strings.Join(keys, ", "))
+	}
+	if err := c.Validate(); err != nil {
+		return Config{}, true, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, true, nil
+}
+
+// Validate checks the settings this package understands; themes and key
+// bindings are checked by the UI.
+func (c Config) Validate() error {
+	var errs []error
+	if _, _, err := c.Location(); err != nil {
+		errs = append(errs, err)
+	}
+	if !contains(Views, c.DefaultView) {
+		errs = append(errs, fmt.Errorf("default_view %q: want one of %s", c.DefaultView, strings.Join(Views, ", ")))
+	}
+	if err := checkURL("source.ergast_url", c.Source.ErgastURL, false); err != nil {
+		errs = append(errs, err)
+	}
+	if err := checkURL("source.openf1_url", c.Source.OpenF1URL, true); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Location resolves Timezone: loc is the zone to use, or nil for the local
+// zone; track reports that times follow each circuit's zone.
+func (c Config) Location() (loc *time.Location, track bool, err error) {
+	switch strings.ToLower(c.Timezone) {
+	case "", "local":
+		return nil, false, nil
+	case "track":
+		return nil, true, nil
+	case "utc":
+		return time.UTC, false, nil
+	}
+	loc, err = time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, false, fmt.Errorf("timezone %q: want local, track, utc or an IANA zone", c.Timezone)
+	}
+	return loc, false, nil
+}
+
+// Encode writes c as TOML.
+func (c Config) Encode(w io.Writer) error {
+	enc := toml.NewEncoder(w)
+	enc.Indent = ""
+	return enc.Encode(c)
+}
+
+func checkURL(name, s string, optional bool) error {
+	if s == "" && optional {
+		return nil
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s %q: want an http or https URL", name, s)
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// write saves content as a config file in a temporary directory.
+func write(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadMissing(t *testing.T) {
+	c, found, err := Load(filepath.Join(t.TempDir(), "config.toml"))
+	if err != nil || found {
+		t.Fatalf("found %v, err %v", found, err)
+	}
+	if c.Timezone != Default().Timezone || c.Source != Default().Source || !c.Cache.Enabled {
+		t.Errorf("got %+v, want the defaults", c)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	c, found, err := Load(write(t, `
+timezone = "Europe/Berlin"
+default_view = "calendar"
+
+[favourites]
+driver = "hamilton"
+
+[cache]
+enabled = false
+
+[keys]
+next = ["right", "l"]
+`))
+	if err != nil || !found {
+		t.Fatalf("found %v, err %v", found, err)
+	}
+	if c.Timezone != "Europe/Berlin" || c.DefaultView != "calendar" || c.Favourites.Driver != "hamilton" || c.Cache.Enabled {
+		t.Errorf("settings not read: %+v", c)
+	}
+	if c.Theme != "default" || c.Source.ErgastURL != Default().Source.ErgastURL {
+		t.Errorf("missing settings lost their defaults: %+v", c)
+	}
+	if got := strings.Join(c.Keys["next"], ","); got != "right,l" {
+		t.Errorf("keys.next = %s", got)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"unknown setting", `colour = "red"`, "unknown settings: colour"},
+		{"unknown nested setting", "[cache]\nttl = 5", "unknown settings: cache.ttl"},
+		{"invalid timezone", `timezone = "Mars/Olympus_Mons"`, `timezone "Mars/Olympus_Mons"`},
+		{"invalid view", `default_view = "laps"`, `default_view "laps"`},
+		{"invalid url", "[source]\nergast_url = \"api.jolpi.ca\"", "source.ergast_url"},
+		{"bad syntax", `timezone = `, "config.toml"},
+		{"wrong type", `timezone = 5`, "config.toml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, found, err := Load(write(t, tt.content))
+			if !found {
+				t.Error("found = false for an existing file")
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	tests := []struct {
+		tz    string
+		want  string // "" for the local zone
+		track bool
+	}{
+		{"", "", false},
+		{"local", "", false},
+		{"track", "", true},
+		{"UTC", "UTC", false},
+		{"utc", "UTC", false},
+		{"America/New_York", "America/New_York", false},
+	}
+	for _, tt := range tests {
+		loc, track, err := Config{Timezone: tt.tz}.Location()
+		if err != nil {
+			t.Errorf("%q: %v", tt.tz, err)
+			continue
+		}
+		got := ""
+		if loc != nil {
+			got = loc.String()
+		}
+		if got != tt.want || track != tt.track {
+			t.Errorf("%q: got %q track %v, want %q track %v", tt.tz, got, track, tt.want, tt.track)
+		}
+	}
+	if _, _, err := (Config{Timezone: "Nowhere/Special"}).Location(); err == nil {
+		t.Error("invalid zone: want an error")
+	}
+}
+
+func TestValidateDefault(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Errorf("defaults do not validate: %v", err)
+	}
+	c := Default()
+	c.Source.OpenF1URL = ""
+	if err := c.Validate(); err != nil {
+		t.Errorf("an empty openf1_url disables live timing: %v", err)
+	}
+}
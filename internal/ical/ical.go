@@ -0,0 +1,171 @@
+// Package ical writes race weekends as RFC 5545 iCalendar files.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// Event is one VEVENT. UID must stay the same across exports so calendar
+// apps update an event on re-import instead of adding a copy.
+type Event struct {
+	UID         string
+	Summary     string
+	Location    string
+	Description string
+	URL         string
+	Lat, Long   string // GEO, both empty to omit it
+	Start, End  time.Time
+}
+
+// SeasonEvents turns every session of races into events. A non-empty round
+// keeps only that round.
+func SeasonEvents(races []models.Race, round string, resultsURL func(season, round string) string) ([]Event, error) {
+	var events []Event
+	visits := map[string]int{}
+	for _, r := range races {
+		visits[r.Season+"/"+r.Circuit.CircuitID]++
+		visit := visits[r.Season+"/"+r.Circuit.CircuitID]
+		if round != "" && r.Round != round {
+			continue
+		}
+		sessions, race, err := utils.BuildUISessions(r, r.Season, r.Round, time.UTC, resultsURL)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.RaceName, err)
+		}
+
+		c := r.Circuit
+		location := c.CircuitName
+		if c.Location.Locality != "" {
+			location += ", " + c.Location.Locality
+		}
+		if c.Location.Country != "" {
+			location += ", " + c.Location.Country
+		}
+
+		for _, s := range append(sessions, race) {
+			events = append(events, Event{
+				UID:         uid(r, visit, s.Kind),
+				Summary:     fmt.Sprintf("%s: %s", r.RaceName, s.Kind),
+				Location:    location,
+				Description: fmt.Sprintf("Round %s of the %s Formula 1 season", r.Round, r.Season),
+				URL:         s.URL,
+				Lat:         c.Location.Lat,
+				Long:        c.Location.Long,
+				Start:       s.Start,
+				End:         s.End,
+			})
+		}
+	}
+	return events, nil
+}
+
+// uid identifies a session by season, circuit and kind, e.g.
+// "2025-miami-sprint-shootout@f1-tui", so it survives rounds being
+// renumbered when the calendar changes. A second visit to a circuit in a
+// season is "-2"; without a circuit ID the round stands in.
+func uid(r models.Race, visit int, kind string) string {
+	slug := strings.ToLower(strings.ReplaceAll(kind, " ", "-"))
+	venue := r.Circuit.CircuitID
+	if venue == "" {
+		venue = "round-" + r.Round
+	} else if visit > 1 {
+		venue = fmt.Sprintf("%s-%d", venue, visit)
+	}
+	return fmt.Sprintf("%s-%s-%s@f1-tui", r.Season, venue, slug)
+}
+
+// Write writes events as a VCALENDAR named name. stamp is the DTSTAMP of
+// every event, normally the time of the export.
+func Write(w io.Writer, name string, events []Event, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		bw.WriteString(fold(s))
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//f1-tui//F1 schedule//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escape(name))
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + e.UID)
+		line("DTSTAMP:" + formatTime(stamp))
+		line("DTSTART:" + formatTime(e.Start))
+		line("DTEND:" + formatTime(e.End))
+		line("SUMMARY:" + escape(e.Summary))
+		if e.Location != "" {
+			line("LOCATION:" + escape(e.Location))
+		}
+		if e.Lat != "" && e.Long != "" {
+			line("GEO:" + e.Lat + ";" + e.Long)
+		}
+		if e.Description != "" {
+			line("DESCRIPTION:" + escape(e.Description))
+		}
+		if e.URL != "" {
+			line("URL:" + e.URL)
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escape escapes a TEXT value (RFC 5545 3.3.11).
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// fold ends a content line with CRLF, folding it so no line is longer than
+// 75 octets without splitting a UTF-8 sequence (RFC 5545 3.1).
+func fold(s string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range s {
+		size := len(string(r))
+		if n+size > 75 {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(r)
+		n += size
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// FileName is the default export file name for a season, or for one round
+// of it: "f1-2025.ics", "f1-2025-round-5.ics".
+func FileName(season, round string) string {
+	if round != "" {
+		return fmt.Sprintf("f1-%s-round-%s.ics", season, round)
+	}
+	return fmt.Sprintf("f1-%s.ics", season)
+}
+
+// WriteFile exports events to path as a calendar named name.
+func WriteFile(path, name string, events []Event) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(f, name, events, time.Now()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
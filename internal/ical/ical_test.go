@@ -0,0 +1,137 @@
+package ical
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestWriteGolden(t *testing.T) {
+	start := time.Date(2025, 11, 9, 17, 0, 0, 0, time.UTC)
+	events := []Event{
+		{
+			UID:         "2025-interlagos-race@f1-tui",
+			Summary:     "São Paulo Grand Prix: Race",
+			Location:    "Autódromo José Carlos Pace, Interlagos; São Paulo, Brazil",
+			Description: `Round 21 of the 2025 Formula 1 season; back\slash, commas, and a description long enough to fold more than once: the São Paulo weekend runs under the sprint format, with the sprint on Saturday`,
+			URL:         "https://example.com/results/formula-one/2025/round-21",
+			Lat:         "-23.7036",
+			Long:        "-46.6997",
+			Start:       start,
+			End:         start.Add(2 * time.Hour),
+		},
+		{
+			UID:     "2025-interlagos-sprint@f1-tui",
+			Summary: "Sprint",
+			Start:   start.Add(-26 * time.Hour),
+			End:     start.Add(-25 * time.Hour),
+		},
+	}
+
+	var buf bytes.Buffer
+	stamp := time.Date(2025, 10, 16, 8, 30, 0, 0, time.UTC)
+	if err := Write(&buf, "F1 2025, Brazil", events, stamp); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "write.ics")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("output differs from %s; run go test -update to rewrite it\ngot:\n%s", golden, buf.String())
+	}
+}
+
+func TestFold(t *testing.T) {
+	long := "DESCRIPTION:" + strings.Repeat("Fórmula 1 ", 30)
+	folded := fold(long)
+	if !strings.HasSuffix(folded, "\r\n") {
+		t.Fatal("line does not end in CRLF")
+	}
+	lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("%d octets not folded", len(long))
+	}
+	for i, l := range lines {
+		if len(l) > 75 {
+			t.Errorf("line %d is %d octets", i, len(l))
+		}
+		if i > 0 && !strings.HasPrefix(l, " ") {
+			t.Errorf("continuation line %d does not start with a space", i)
+		}
+		if !utf8.ValidString(l) {
+			t.Errorf("line %d splits a UTF-8 sequence: %q", i, l)
+		}
+	}
+	if got := strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""); got != long {
+		t.Errorf("unfolding does not give the line back:\n%s", got)
+	}
+
+	if got := fold(strings.Repeat("x", 75)); got != strings.Repeat("x", 75)+"\r\n" {
+		t.Error("a 75 octet line was folded")
+	}
+}
+
+func TestEscape(t *testing.T) {
+	got := escape("a,b;c\\d\ne")
+	if want := `a\,b\;c\\d\ne`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func gp(round, circuitID, date string) models.Race {
+	r := models.Race{Season: "2020", Round: round, RaceName: "Grand Prix " + round, Date: date, Time: "13:10:00Z"}
+	r.Circuit.CircuitID = circuitID
+	r.Circuit.CircuitName = circuitID
+	return r
+}
+
+func TestSeasonEventsUID(t *testing.T) {
+	url := func(season, round string) string { return "" }
+	uids := func(races []models.Race, round string) []string {
+		events, err := SeasonEvents(races, round, url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out []string
+		for _, e := range events {
+			out = append(out, e.UID)
+		}
+		return out
+	}
+
+	before := []models.Race{gp("1", "red_bull_ring", "2020-07-05"), gp("2", "red_bull_ring", "2020-07-12"), gp("3", "hungaroring", "2020-07-19")}
+	// The first race is cancelled and the others renumbered.
+	after := []models.Race{gp("1", "red_bull_ring", "2020-07-05"), gp("2", "hungaroring", "2020-07-19")}
+
+	if got := uids(before, ""); strings.Join(got, " ") != "2020-red_bull_ring-race@f1-tui 2020-red_bull_ring-2-race@f1-tui 2020-hungaroring-race@f1-tui" {
+		t.Errorf("UIDs: %v", got)
+	}
+	if got := uids(before, "3"); len(got) != 1 || got[0] != "2020-hungaroring-race@f1-tui" {
+		t.Errorf("round 3: %v", got)
+	}
+	if got := uids(after, "2"); len(got) != 1 || got[0] != "2020-hungaroring-race@f1-tui" {
+		t.Errorf("renumbered round: %v, want the same UID as before", got)
+	}
+
+	noID := gp("7", "", "2020-08-30")
+	if got := uids([]models.Race{noID}, ""); got[0] != "2020-round-7-race@f1-tui" {
+		t.Errorf("without a circuit ID: %v", got)
+	}
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OpenF1Session is an entry of the OpenF1 /sessions endpoint.
+type OpenF1Session struct {
+	SessionKey       int       `json:"session_key"`
+	MeetingKey       int       `json:"meeting_key"`
+	SessionName      string    `json:"session_name"`
+	SessionType      string    `json:"session_type"`
+	DateStart        time.Time `json:"date_start"`
+	DateEnd          time.Time `json:"date_end"`
+	Year             int       `json:"year"`
+	CountryName      string    `json:"country_name"`
+	CountryCode      string    `json:"country_code"`
+	CircuitShortName string    `json:"circuit_short_name"`
+	Location         string    `json:"location"`
+	GMTOffset        string    `json:"gmt_offset"`
+}
+
+// OpenF1Driver is an entry of the OpenF1 /drivers endpoint.
+type OpenF1Driver struct {
+	SessionKey    int    `json:"session_key"`
+	DriverNumber  int    `json:"driver_number"`
+	BroadcastName string `json:"broadcast_name"`
+	FullName      string `json:"full_name"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	NameAcronym   string `json:"name_acronym"`
+	TeamName      string `json:"team_name"`
+	TeamColour    string `json:"team_colour"`
+	CountryCode   string `json:"country_code"`
+	HeadshotURL   string `json:"headshot_url"`
+}
+
+// OpenF1Lap is an entry of the OpenF1 /laps endpoint. Durations are in
+// seconds and nil when not timed.
+type OpenF1Lap struct {
+	SessionKey      int       `json:"session_key"`
+	DriverNumber    int       `json:"driver_number"`
+	LapNumber       int       `json:"lap_number"`
+	DateStart       time.Time `json:"date_start"`
+	LapDuration     *float64  `json:"lap_duration"`
+	DurationSector1 *float64  `json:"duration_sector_1"`
+	DurationSector2 *float64  `json:"duration_sector_2"`
+	DurationSector3 *float64  `json:"duration_sector_3"`
+	I1Speed         *int      `json:"i1_speed"`
+	I2Speed         *int      `json:"i2_speed"`
+	STSpeed         *int      `json:"st_speed"`
+	IsPitOutLap     bool      `json:"is_pit_out_lap"`
+}
+
+// OpenF1Position is an entry of the OpenF1 /position endpoint: a driver's
+// position from Date on.
+type OpenF1Position struct {
+	SessionKey   int       `json:"session_key"`
+	DriverNumber int       `json:"driver_number"`
+	Date         time.Time `json:"date"`
+	Position     int       `json:"position"`
+}
+
+// OpenF1Interval is an entry of the OpenF1 /intervals endpoint.
+type OpenF1Interval struct {
+	SessionKey   int       `json:"session_key"`
+	DriverNumber int       `json:"driver_number"`
+	Date         time.Time `json:"date"`
+	GapToLeader  Gap       `json:"gap_to_leader"`
+	Interval     Gap       `json:"interval"`
+}
+
+// OpenF1Pit is an entry of the OpenF1 /pit endpoint.
+type OpenF1Pit struct {
+	SessionKey   int       `json:"session_key"`
+	DriverNumber int       `json:"driver_number"`
+	Date         time.Time `json:"date"`
+	LapNumber    int       `json:"lap_number"`
+	PitDuration  *float64  `json:"pit_duration"`
+}
+
+// OpenF1Stint is an entry of the OpenF1 /stints endpoint.
+type OpenF1Stint struct {
+	SessionKey     int    `json:"session_key"`
+	DriverNumber   int    `json:"driver_number"`
+	StintNumber    int    `json:"stint_number"`
+	LapStart       int    `json:"lap_start"`
+	LapEnd         int    `json:"lap_end"`
+	Compound       string `json:"compound"`
+	TyreAgeAtStart int    `json:"tyre_age_at_start"`
+}
+
+// OpenF1RaceControl is an entry of the OpenF1 /race_control endpoint.
+type OpenF1RaceControl struct {
+	SessionKey   int       `json:"session_key"`
+	Date         time.Time `json:"date"`
+	Category     string    `json:"category"`
+	Flag         string    `json:"flag"`
+	Scope        string    `json:"scope"`
+	Sector       *int      `json:"sector"`
+	LapNumber    *int      `json:"lap_number"`
+	DriverNumber *int      `json:"driver_number"`
+	Message      string    `json:"message"`
+}
+
+// Gap is an OpenF1 time gap, sent as seconds, as a string such as
+// "+1 LAP", or null. It holds the text to display.
+type Gap string
+
+func (g *Gap) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+		*g = ""
+	case float64:
+		*g = Gap(fmt.Sprintf("+%.3f", v))
+	case string:
+		*g = Gap(v)
+	default:
+		return fmt.Errorf("unexpected gap %s", b)
+	}
+	return nil
+}
+
+// SessionTiming is a classification built from live timing data.
+type SessionTiming struct {
+	SessionName string
+	Results     []DriverResult
+	LastMessage string // latest race control message, if any
+}
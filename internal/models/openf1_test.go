@@ -0,0 +1,48 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGapUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Gap
+	}{
+		{`1.234`, "+1.234"},
+		{`0`, "+0.000"},
+		{`12`, "+12.000"},
+		{`"+1 LAP"`, "+1 LAP"},
+		{`"+3 LAPS"`, "+3 LAPS"},
+		{`null`, ""},
+	}
+	for _, tt := range tests {
+		g := Gap("stale")
+		if err := json.Unmarshal([]byte(tt.in), &g); err != nil {
+			t.Errorf("%s: %v", tt.in, err)
+			continue
+		}
+		if g != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.in, g, tt.want)
+		}
+	}
+
+	for _, in := range []string{`true`, `[1]`, `{"gap": 1}`} {
+		var g Gap
+		if err := json.Unmarshal([]byte(in), &g); err == nil {
+			t.Errorf("%s: want an error, got %q", in, g)
+		}
+	}
+}
+
+func TestIntervalGapInStruct(t *testing.T) {
+	var iv OpenF1Interval
+	err := json.Unmarshal([]byte(`{"driver_number": 4, "gap_to_leader": null, "interval": 0.5}`), &iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iv.GapToLeader != "" || iv.Interval != "+0.500" {
+		t.Errorf("got gap %q interval %q", iv.GapToLeader, iv.Interval)
+	}
+}
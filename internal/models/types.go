@@ -3,10 +3,53 @@ package models
 import "time"
 
 type ErgastMRData struct {
+	Limit  string `json:"limit"`
+	Offset string `json:"offset"`
+	Total  string `json:"total"`
+
 	RaceTable struct {
 		Season string `json:"season"`
 		Races  []Race `json:"Races"`
 	} `json:"RaceTable"`
+	DriverTable struct {
+		Drivers []Driver `json:"Drivers"`
+	} `json:"DriverTable"`
+	ConstructorTable struct {
+		Constructors []Constructor `json:"Constructors"`
+	} `json:"ConstructorTable"`
+	StandingsTable struct {
+		Season         string          `json:"season"`
+		Round          string          `json:"round"`
+		StandingsLists []StandingsList `json:"StandingsLists"`
+	} `json:"StandingsTable"`
+}
+
+type StandingsList struct {
+	Season          string                `json:"season"`
+	Round           string                `json:"round"`
+	DriverStandings []DriverStandingEntry `json:"DriverStandings,omitempty"`
+
+	ConstructorStandings []ConstructorStandingEntry `json:"ConstructorStandings,omitempty"`
+}
+
+// DriverStandingEntry is one row of the drivers' championship, as sent by Ergast.
+type DriverStandingEntry struct {
+	Position     string        `json:"position"`
+	PositionText string        `json:"positionText"`
+	Points       string        `json:"points"`
+	Wins         string        `json:"wins"`
+	Driver       Driver        `json:"Driver"`
+	Constructors []Constructor `json:"Constructors"`
+}
+
+// ConstructorStandingEntry is one row of the constructors' championship, as
+// sent by Ergast.
+type ConstructorStandingEntry struct {
+	Position     string      `json:"position"`
+	PositionText string      `json:"positionText"`
+	Points       string      `json:"points"`
+	Wins         string      `json:"wins"`
+	Constructor  Constructor `json:"Constructor"`
 }
 
 type Race struct {
@@ -22,9 +65,16 @@ type Race struct {
 	Sprint         *Session `json:"Sprint,omitempty"`
 	SprintShootout *Session `json:"SprintShootout,omitempty"`
 	Qualifying     *Session `json:"Qualifying,omitempty"`
+
+	Results           []RaceResult       `json:"Results,omitempty"`
+	SprintResults     []RaceResult       `json:"SprintResults,omitempty"`
+	QualifyingResults []QualifyingResult `json:"QualifyingResults,omitempty"`
+	Laps              []Lap              `json:"Laps,omitempty"`
+	PitStops          []PitStopEntry     `json:"PitStops,omitempty"`
 }
 
 type Circuit struct {
+	CircuitID   string `json:"circuitId"`
 	CircuitName string `json:"circuitName"`
 	Location    struct {
 		Locality string `json:"locality"`
@@ -35,6 +85,90 @@ type Circuit struct {
 	URL string `json:"url"`
 }
 
+type Driver struct {
+	DriverID        string `json:"driverId"`
+	PermanentNumber string `json:"permanentNumber"`
+	Code            string `json:"code"`
+	URL             string `json:"url"`
+	GivenName       string `json:"givenName"`
+	FamilyName      string `json:"familyName"`
+	DateOfBirth     string `json:"dateOfBirth"`
+	Nationality     string `json:"nationality"`
+}
+
+type Constructor struct {
+	ConstructorID string `json:"constructorId"`
+	URL           string `json:"url"`
+	Name          string `json:"name"`
+	Nationality   string `json:"nationality"`
+}
+
+type ResultTime struct {
+	Millis string `json:"millis"`
+	Time   string `json:"time"`
+}
+
+type FastestLap struct {
+	Rank string `json:"rank"`
+	Lap  string `json:"lap"`
+	Time struct {
+		Time string `json:"time"`
+	} `json:"Time"`
+	AverageSpeed struct {
+		Units string `json:"units"`
+		Speed string `json:"speed"`
+	} `json:"AverageSpeed"`
+}
+
+// RaceResult is one classified entry of a race or sprint, as sent by Ergast.
+type RaceResult struct {
+	Number       string      `json:"number"`
+	Position     string      `json:"position"`
+	PositionText string      `json:"positionText"`
+	Points       string      `json:"points"`
+	Driver       Driver      `json:"Driver"`
+	Constructor  Constructor `json:"Constructor"`
+	Grid         string      `json:"grid"`
+	Laps         string      `json:"laps"`
+	Status       string      `json:"status"`
+	Time         *ResultTime `json:"Time,omitempty"`
+	FastestLap   *FastestLap `json:"FastestLap,omitempty"`
+}
+
+// QualifyingResult is one entry of a qualifying session, as sent by Ergast.
+type QualifyingResult struct {
+	Number      string      `json:"number"`
+	Position    string      `json:"position"`
+	Driver      Driver      `json:"Driver"`
+	Constructor Constructor `json:"Constructor"`
+	Q1          string      `json:"Q1"`
+	Q2          string      `json:"Q2"`
+	Q3          string      `json:"Q3"`
+}
+
+// Lap is one lap of a race with the timing of every driver on it, as sent
+// by Ergast.
+type Lap struct {
+	Number  string      `json:"number"`
+	Timings []LapTiming `json:"Timings"`
+}
+
+type LapTiming struct {
+	DriverID string `json:"driverId"`
+	Position string `json:"position"`
+	Time     string `json:"time"`
+}
+
+// PitStopEntry is one pit stop in a race, as sent by Ergast. Time is the
+// local time of day; Duration is pit lane time, not stationary time.
+type PitStopEntry struct {
+	DriverID string `json:"driverId"`
+	Lap      string `json:"lap"`
+	Stop     string `json:"stop"`
+	Time     string `json:"time"`
+	Duration string `json:"duration"`
+}
+
 type Session struct {
 	Date string `json:"date"`
 	Time string `json:"time"`
@@ -53,18 +187,170 @@ type SessionRow struct {
 }
 
 type ResultsView struct {
+	Season      string
+	Round       string
 	SessionName string
 	RaceName    string
 	Results     []DriverResult
 	Loading     bool
 	Error       error
+
+	Live        bool           // session in progress, results are polled
+	RaceControl string         // latest race control message from live timing
+	Changes     map[string]int // places gained (+) or lost (-) per driver since the last poll, by ID or car number
+	UpdatedAt   time.Time
 }
 
 type DriverResult struct {
-	Position     string
-	Driver       string
-	Constructor  string
-	Time         string
-	Status       string
-	Points       string
+	Position      string
+	PositionText  string // Position if classified, else e.g. "R" for retired
+	DriverID      string
+	Driver        string
+	ConstructorID string
+	Constructor   string
+	Time          string
+	Status        string
+	Points        string
+
+	Q1, Q2, Q3 string // qualifying segment times, "" if not set
+
+	Number         string
+	Grid           string // "0" for a pit lane start
+	Laps           string
+	FastestLap     string
+	FastestLapRank string
+}
+
+type DriverStanding struct {
+	Position       string
+	DriverID       string
+	Driver         string
+	Nationality    string
+	Constructor    string
+	ConstructorIDs []string // every team the driver scored for this season
+	Wins           string
+	Points         string
+}
+
+// DriverStandingsTable is the drivers' championship after Round of Season.
+type DriverStandingsTable struct {
+	Season    string
+	Round     string
+	Standings []DriverStanding
+}
+
+type ConstructorStanding struct {
+	Position      string
+	ConstructorID string
+	Constructor   string
+	Nationality   string
+	Wins          string
+	Points        string
+}
+
+// ConstructorStandingsTable is the constructors' championship after Round
+// of Season.
+type ConstructorStandingsTable struct {
+	Season    string
+	Round     string
+	Standings []ConstructorStanding
+}
+
+// TeamDriversView is the drill-down from a constructors' standings row to
+// the drivers who scored for that team.
+type TeamDriversView struct {
+	Team    ConstructorStanding
+	Season  string
+	Round   string
+	Drivers []DriverStanding
+	Loading bool
+	Error   error
+}
+
+// LapTime is one timed lap of a driver.
+type LapTime struct {
+	DriverID string
+	Driver   string
+	Lap      int
+	Position string
+	Time     string
+	Duration time.Duration
+}
+
+// LapsView is the lap by lap chart of one driver in a race.
+type LapsView struct {
+	Season   string
+	Round    string
+	RaceName string
+	DriverID string
+	Driver   string
+	Laps     []LapTime
+	Fastest  LapTime // fastest lap of the race, by anyone
+	Loading  bool
+	Error    error
+}
+
+type PitStop struct {
+	DriverID    string
+	Driver      string
+	Constructor string
+	Stop        int
+	Lap         int
+	TimeOfDay   string
+	Duration    string
+	PitTime     time.Duration // Duration parsed, 0 if unknown
+}
+
+// PitStopsView is the pit stop analysis of a race.
+type PitStopsView struct {
+	Season   string
+	Round    string
+	RaceName string
+	Stops    []PitStop
+	Loading  bool
+	Error    error
+}
+
+// SeasonResult is how one car finished one round of a season.
+type SeasonResult struct {
+	Round       string
+	RaceName    string
+	Sprint      bool // a sprint result rather than the Grand Prix
+	DriverID    string
+	Driver      string
+	Constructor string
+	Grid        string
+	Position    string
+	Points      string
+	Status      string
+}
+
+// CareerStats are a driver's world championship totals. Poles counts
+// starts from pole, which Ergast has for every season.
+type CareerStats struct {
+	Seasons int
+	Starts  int
+	Wins    int
+	Poles   int
+	Podiums int
+}
+
+// DriverProfileView is a driver's profile with their results in Season.
+type DriverProfileView struct {
+	Driver  Driver
+	Season  string
+	Results []SeasonResult
+	Career  CareerStats
+	Loading bool
+	Error   error
+}
+
+// ConstructorProfileView is a constructor's profile with its cars' results
+// in Season.
+type ConstructorProfileView struct {
+	Constructor Constructor
+	Season      string
+	Results     []SeasonResult
+	Loading     bool
+	Error       error
 }
@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+type calendarWinnersMsg struct {
+	season  string
+	winners []models.SeasonResult
+}
+
+type calendarErrMsg struct {
+	season string
+	err    error
+}
+
+func fetchCalendarWinnersCmd(src api.DataSource, season string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		winners, err := src.RaceWinners(ctx, season)
+		if err != nil {
+			return calendarErrMsg{season: season, err: err}
+		}
+		return calendarWinnersMsg{season: season, winners: winners}
+	})
+}
+
+func (m *Model) loadCalendarWinners() tea.Cmd {
+	m.calendarSt.season = m.season
+	m.calendarSt.loading = true
+	m.calendarSt.err = nil
+	return fetchCalendarWinnersCmd(m.src, m.season)
+}
+
+// calendarColumns are the columns of the season overview, before
+// fitColumns.
+func calendarColumns() []table.Column {
+	return []table.Column{
+		{Title: "Rd", Width: 3},
+		{Title: "", Width: 4},
+		{Title: "Grand Prix", Width: 26},
+		{Title: "Date", Width: 10},
+		{Title: "Sprint", Width: 6},
+		{Title: "Winner", Width: 20},
+		{Title: "Status", Width: 8},
+	}
+}
+
+// raceStatus is "finished", "next" or "upcoming" for each of races at now;
+// only the first GP not over yet is next.
+func raceStatus(races []models.Race, now time.Time) []string {
+	status := make([]string, len(races))
+	next := false
+	for i, r := range races {
+		_, race, err := utils.BuildUISessions(r, r.Season, r.Round, time.UTC, func(string, string) string { return "" })
+		switch {
+		case err == nil && now.After(race.End):
+			status[i] = "finished"
+		case !next:
+			status[i] = "next"
+			next = true
+		default:
+			status[i] = "upcoming"
+		}
+	}
+	return status
+}
+
+// setCalendarRows lists the season, one row per GP in m.races so the
+// cursor indexes them directly.
+func (m *Model) setCalendarRows() {
+	winners := map[string]string{}
+	if m.calendarWinners.season == m.season {
+		for _, w := range m.calendarWinners.winners {
+			winners[w.Round] = w.Driver
+		}
+	}
+
+	status := raceStatus(m.races, time.Now())
+	rows := []table.Row{}
+	for i, r := range m.races {
+		flag := ""
+		if code := utils.CountryNameToCode(r.Circuit.Location.Country, r.RaceName, r.Circuit.CircuitName); code != "" {
+			flag = utils.CountryCodeToFlag(code)
+		}
+		date := r.Date
+		if _, race, err := utils.BuildUISessions(r, r.Season, r.Round, m.location(r), m.src.ResultsURL); err == nil {
+			date = race.Start.Format("Jan _2")
+		}
+		sprint := ""
+		if r.Sprint != nil {
+			sprint = "S"
+		}
+		rows = append(rows, table.Row{r.Round, flag, r.RaceName, date, sprint, winners[r.Round], status[i]})
+	}
+
+	m.calendarTbl.SetRows(rows)
+	m.calendarTbl.SetCursor(m.idx)
+}
+
+func (m *Model) setCalendarWinners(msg calendarWinnersMsg) {
+	if msg.season != m.calendarSt.season {
+		return
+	}
+	m.calendarSt.loading = false
+	m.calendarSt.err = nil
+	m.calendarWinners = msg
+	m.setCalendarRows()
+}
+
+func (m Model) updateCalendar(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.NextTab):
+		return m, m.switchTab(1)
+	case key.Matches(msg, m.keys.PrevTab):
+		return m, m.switchTab(-1)
+	case key.Matches(msg, m.keys.Open):
+		if i := m.calendarTbl.Cursor(); i >= 0 && i < len(m.races) {
+			m.selectIndex(i)
+			m.mode = viewSchedule
+		}
+		return m, nil
+	case key.Matches(msg, m.keys.Refresh):
+		return m, tea.Batch(m.loadSchedule(), m.loadCalendarWinners())
+	case key.Matches(msg, m.keys.PrevSeason, m.keys.NextSeason):
+		delta := 1
+		if key.Matches(msg, m.keys.PrevSeason) {
+			delta = -1
+		}
+		cmd := m.changeSeason(delta)
+		if cmd == nil {
+			return m, nil
+		}
+		m.calendarTbl.SetRows(nil)
+		return m, tea.Batch(cmd, m.loadCalendarWinners())
+	}
+
+	var cmd tea.Cmd
+	m.calendarTbl, cmd = m.calendarTbl.Update(msg)
+	return m, cmd
+}
+
+func (m Model) renderCalendarView() string {
+	header := m.renderTabs() + "\n\n"
+	if m.loading {
+		return header + LabelStyle.Render(fmt.Sprintf("Loading %s schedule…", m.season))
+	}
+	if m.err != nil {
+		return header + ErrorStyle.Render(m.err.Error()) + "\n" +
+			LabelStyle.Render(m.retryHelp())
+	}
+
+	sprints := 0
+	for _, r := range m.races {
+		if r.Sprint != nil {
+			sprints++
+		}
+	}
+	note := fmt.Sprintf("%d rounds, %d sprint weekends", len(m.races), sprints)
+	switch {
+	case m.calendarSt.loading:
+		note += " • loading winners…"
+	case m.calendarSt.err != nil:
+		note += " • " + ErrorStyle.Render("winners unavailable: "+m.calendarSt.err.Error())
+	}
+
+	footer := "\n" + m.renderHelp()
+
+	return header +
+		GPStyle.Render(m.season+" Season") + "\n" +
+		LabelStyle.Render(note) + "\n\n" +
+		m.calendarTbl.View() + footer
+}
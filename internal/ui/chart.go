@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws one block per value, scaled between lo and hi. Values
+// outside the range are clamped, so outliers don't flatten the rest.
+func sparkline(values []float64, lo, hi float64) string {
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if hi > lo {
+			f := (math.Min(math.Max(v, lo), hi) - lo) / (hi - lo)
+			i = int(math.Round(f * float64(len(sparkBlocks)-1)))
+		}
+		b.WriteRune(sparkBlocks[i])
+	}
+	return b.String()
+}
+
+// median of values, 0 for none.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	s := append([]float64(nil), values...)
+	sort.Float64s(s)
+	return s[len(s)/2]
+}
+
+// barChart draws values as vertical bars height rows tall, one column per
+// value, scaled from zero to the largest value.
+func barChart(values []float64, height int) string {
+	top := 0.0
+	for _, v := range values {
+		top = math.Max(top, v)
+	}
+	if top == 0 || height < 1 {
+		return ""
+	}
+
+	rows := make([]string, height)
+	for r := range rows {
+		var b strings.Builder
+		// Fill of this row, in eighths of a cell, counted from the bottom.
+		floor := float64(height-1-r) * 8
+		for _, v := range values {
+			eighths := v/top*float64(height*8) - floor
+			switch {
+			case eighths >= 8:
+				b.WriteRune('█')
+			case eighths >= 1:
+				b.WriteRune(sparkBlocks[int(eighths)-1])
+			default:
+				b.WriteRune(' ')
+			}
+		}
+		rows[r] = b.String()
+	}
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,242 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+type constructorProfileMsg struct {
+	profile models.ConstructorProfileView
+}
+
+type constructorProfileErrMsg struct {
+	constructorID string
+	err           error
+}
+
+func fetchConstructorProfileCmd(src api.DataSource, season, constructorID string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		c, err := src.Constructor(ctx, constructorID)
+		if err != nil {
+			return constructorProfileErrMsg{constructorID, err}
+		}
+		results, err := src.ConstructorSeasonResults(ctx, season, constructorID)
+		if err != nil {
+			return constructorProfileErrMsg{constructorID, err}
+		}
+		return constructorProfileMsg{models.ConstructorProfileView{
+			Constructor: c,
+			Season:      season,
+			Results:     results,
+		}}
+	})
+}
+
+// openConstructorProfile shows a constructor's profile for season,
+// returning to the current view on esc.
+func (m *Model) openConstructorProfile(season, constructorID, name string) tea.Cmd {
+	if constructorID == "" {
+		return nil
+	}
+	m.profileReturn = m.mode
+	m.mode = viewConstructorProfile
+	m.constructorProfile = models.ConstructorProfileView{
+		Constructor: models.Constructor{ConstructorID: constructorID, Name: name},
+		Season:      season,
+		Loading:     true,
+	}
+	return fetchConstructorProfileCmd(m.src, season, constructorID)
+}
+
+func (m Model) updateConstructorProfile(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		m.mode = m.profileReturn
+		m.constructorProfile = models.ConstructorProfileView{}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.constructorProfileTbl, cmd = m.constructorProfileTbl.Update(msg)
+	return m, cmd
+}
+
+// teamSeason is a constructor's season summed up per round and car.
+type teamSeason struct {
+	drivers    []models.SeasonResult // first result of each driver, in order of appearance
+	rounds     []models.SeasonResult // first result of each round, in round order
+	points     map[string]map[string]float64
+	cumulative []float64
+}
+
+func summarizeTeamSeason(results []models.SeasonResult) teamSeason {
+	sorted := append([]models.SeasonResult(nil), results...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ri, _ := strconv.Atoi(sorted[i].Round)
+		rj, _ := strconv.Atoi(sorted[j].Round)
+		return ri < rj
+	})
+
+	s := teamSeason{points: map[string]map[string]float64{}}
+	seenDriver := map[string]bool{}
+	for _, r := range sorted {
+		if !seenDriver[r.DriverID] {
+			seenDriver[r.DriverID] = true
+			s.drivers = append(s.drivers, r)
+		}
+		if s.points[r.Round] == nil {
+			s.points[r.Round] = map[string]float64{}
+			s.rounds = append(s.rounds, r)
+		}
+		pts, _ := strconv.ParseFloat(r.Points, 64)
+		s.points[r.Round][r.DriverID] += pts
+	}
+
+	total := 0.0
+	for _, r := range s.rounds {
+		for _, p := range s.points[r.Round] {
+			total += p
+		}
+		s.cumulative = append(s.cumulative, total)
+	}
+	return s
+}
+
+// bestFinish is the best Grand Prix position among results and the races
+// it came in.
+func bestFinish(results []models.SeasonResult) (int, []models.SeasonResult) {
+	best := 0
+	var at []models.SeasonResult
+	for _, r := range results {
+		p, err := strconv.Atoi(r.Position)
+		if err != nil || r.Sprint {
+			continue
+		}
+		switch {
+		case best == 0 || p < best:
+			best, at = p, []models.SeasonResult{r}
+		case p == best:
+			at = append(at, r)
+		}
+	}
+	return best, at
+}
+
+func (m *Model) setConstructorProfile(msg constructorProfileMsg) {
+	if msg.profile.Constructor.ConstructorID != m.constructorProfile.Constructor.ConstructorID {
+		return
+	}
+	m.constructorProfile = msg.profile
+	s := summarizeTeamSeason(msg.profile.Results)
+
+	rows := []table.Row{}
+	for i, r := range s.rounds {
+		row := table.Row{r.Round, strings.TrimSuffix(r.RaceName, " Grand Prix")}
+		round := 0.0
+		for _, d := range s.drivers {
+			pts, ok := s.points[r.Round][d.DriverID]
+			if !ok {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, formatPoints(pts))
+			round += pts
+		}
+		row = append(row, formatPoints(round), formatPoints(s.cumulative[i]))
+		rows = append(rows, row)
+	}
+
+	m.constructorProfileTbl.SetRows(nil)
+	m.constructorProfileTbl.SetColumns(fitColumns(constructorProfileColumns(s), m.width))
+	m.constructorProfileTbl.SetRows(rows)
+	m.constructorProfileTbl.GotoTop()
+}
+
+// constructorProfileColumns are a round, a column per driver of s and the
+// team's totals, before fitColumns.
+func constructorProfileColumns(s teamSeason) []table.Column {
+	cols := []table.Column{
+		{Title: "Rd", Width: 3},
+		{Title: "Grand Prix", Width: 16},
+	}
+	for _, d := range s.drivers {
+		cols = append(cols, table.Column{Title: lastName(d.Driver), Width: 10})
+	}
+	return append(cols, table.Column{Title: "Total", Width: 6}, table.Column{Title: "Season", Width: 6})
+}
+
+func formatPoints(p float64) string {
+	return strconv.FormatFloat(p, 'f', -1, 64)
+}
+
+func (m Model) renderConstructorProfileView() string {
+	v := m.constructorProfile
+	c := v.Constructor
+
+	name := c.Name
+	if code := utils.NationalityToCode(c.Nationality); code != "" {
+		name = utils.CountryCodeToFlag(code) + " " + name
+	}
+	header := TitleStyle.Render("Constructor") + "\n" + GPStyle.Render(name) + "\n"
+
+	if v.Loading {
+		return header + LabelStyle.Render("Loading profile…")
+	}
+	if v.Error != nil {
+		return header + ErrorStyle.Render(v.Error.Error()) + "\n" +
+			LabelStyle.Render("Press "+m.keys.Back.Help().Key+" to go back")
+	}
+
+	s := summarizeTeamSeason(v.Results)
+
+	var drivers []string
+	for _, d := range s.drivers {
+		driverBest, _ := bestFinish(filterDriver(v.Results, d.DriverID))
+		if driverBest > 0 {
+			drivers = append(drivers, fmt.Sprintf("%s (best P%d)", d.Driver, driverBest))
+		} else {
+			drivers = append(drivers, d.Driver)
+		}
+	}
+
+	info := LabelStyle.Render(c.Nationality) + "\n" +
+		fmt.Sprintf("%s drivers: %s", v.Season, strings.Join(drivers, ", "))
+	if best, at := bestFinish(v.Results); best > 0 {
+		var where []string
+		for _, r := range at {
+			where = append(where, fmt.Sprintf("%s, %s", strings.TrimSuffix(r.RaceName, " Grand Prix"), lastName(r.Driver)))
+		}
+		info += "\n" + fmt.Sprintf("Best finish: P%d ×%d (%s)", best, len(at), strings.Join(where, "; "))
+	}
+
+	chart := ""
+	if len(s.cumulative) > 0 {
+		chart = TitleStyle.Render(fmt.Sprintf("Points progression (%s pts)", formatPoints(s.cumulative[len(s.cumulative)-1]))) + "\n" +
+			CircuitStyle.Render(barChart(s.cumulative, 6)) + "\n\n"
+	}
+
+	footer := "\n" + LabelStyle.Render("Points include sprints") + "\n" + m.renderHelp()
+
+	return header + info + "\n\n" + chart + m.constructorProfileTbl.View() + footer
+}
+
+func filterDriver(results []models.SeasonResult, driverID string) []models.SeasonResult {
+	var out []models.SeasonResult
+	for _, r := range results {
+		if r.DriverID == driverID {
+			out = append(out, r)
+		}
+	}
+	return out
+}
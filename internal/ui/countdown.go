@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// clockTickMsg redraws the countdown once a second.
+type clockTickMsg struct{}
+
+func clockTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return clockTickMsg{}
+	})
+}
+
+// renderCountdown is the "Qualifying in 2d 04:13:22" line of the schedule
+// view, or "" when the season has no sessions left.
+func (m Model) renderCountdown(now time.Time) string {
+	next, ok := utils.NextSession(m.calendar, now)
+	if !ok {
+		return ""
+	}
+	gp := LabelStyle.Render(" · " + next.Race.RaceName)
+	if isLive(next.Session, now) {
+		return next.Session.Kind + " " + LiveBadge.Render("LIVE") + gp
+	}
+	return fmt.Sprintf("%s in %s", next.Session.Kind, utils.FormatCountdown(next.Session.Start.Sub(now))) + gp
+}
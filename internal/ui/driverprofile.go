@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+type driverProfileMsg struct {
+	profile models.DriverProfileView
+}
+
+type driverProfileErrMsg struct {
+	driverID string
+	err      error
+}
+
+func fetchDriverProfileCmd(src api.DataSource, season, driverID string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		driver, err := src.Driver(ctx, driverID)
+		if err != nil {
+			return driverProfileErrMsg{driverID, err}
+		}
+		results, err := src.DriverSeasonResults(ctx, season, driverID)
+		if err != nil {
+			return driverProfileErrMsg{driverID, err}
+		}
+		career, err := src.DriverCareer(ctx, driverID)
+		if err != nil {
+			return driverProfileErrMsg{driverID, err}
+		}
+		return driverProfileMsg{models.DriverProfileView{
+			Driver:  driver,
+			Season:  season,
+			Results: results,
+			Career:  career,
+		}}
+	})
+}
+
+// openDriverProfile shows a driver's profile for season, returning to the
+// current view on esc.
+func (m *Model) openDriverProfile(season, driverID, name string) tea.Cmd {
+	if driverID == "" {
+		return nil
+	}
+	m.profileReturn = m.mode
+	m.mode = viewDriverProfile
+	m.driverProfile = models.DriverProfileView{
+		Driver:  models.Driver{DriverID: driverID, GivenName: name},
+		Season:  season,
+		Loading: true,
+	}
+	return fetchDriverProfileCmd(m.src, season, driverID)
+}
+
+func (m Model) updateDriverProfile(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		m.mode = m.profileReturn
+		m.driverProfile = models.DriverProfileView{}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.driverProfileTbl, cmd = m.driverProfileTbl.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) setDriverProfile(msg driverProfileMsg) {
+	if msg.profile.Driver.DriverID != m.driverProfile.Driver.DriverID {
+		return
+	}
+	m.driverProfile = msg.profile
+
+	rows := []table.Row{}
+	for _, r := range msg.profile.Results {
+		rows = append(rows, table.Row{
+			r.Round,
+			strings.TrimSuffix(r.RaceName, " Grand Prix"),
+			r.Constructor,
+			r.Grid,
+			r.Position,
+			r.Points,
+			r.Status,
+		})
+	}
+	m.driverProfileTbl.SetRows(rows)
+	m.driverProfileTbl.GotoTop()
+}
+
+// age is the number of whole years from dob (YYYY-MM-DD) to now.
+func age(dob string, now time.Time) (int, bool) {
+	born, err := time.Parse("2006-01-02", dob)
+	if err != nil {
+		return 0, false
+	}
+	years := now.Year() - born.Year()
+	if now.Month() < born.Month() || (now.Month() == born.Month() && now.Day() < born.Day()) {
+		years--
+	}
+	return years, true
+}
+
+func (m Model) renderDriverProfileView() string {
+	v := m.driverProfile
+	d := v.Driver
+
+	name := strings.TrimSpace(d.GivenName + " " + d.FamilyName)
+	if code := utils.NationalityToCode(d.Nationality); code != "" {
+		name = utils.CountryCodeToFlag(code) + " " + name
+	}
+	header := TitleStyle.Render("Driver") + "\n" + GPStyle.Render(name) + "\n"
+
+	if v.Loading {
+		return header + LabelStyle.Render("Loading profile…")
+	}
+	if v.Error != nil {
+		return header + ErrorStyle.Render(v.Error.Error()) + "\n" +
+			LabelStyle.Render("Press "+m.keys.Back.Help().Key+" to go back")
+	}
+
+	var facts []string
+	if d.PermanentNumber != "" {
+		facts = append(facts, "#"+d.PermanentNumber)
+	}
+	if d.Code != "" {
+		facts = append(facts, d.Code)
+	}
+	facts = append(facts, d.Nationality)
+	if years, ok := age(d.DateOfBirth, time.Now()); ok {
+		facts = append(facts, fmt.Sprintf("born %s (age %d)", d.DateOfBirth, years))
+	}
+
+	c := v.Career
+	career := fmt.Sprintf("Career: %d seasons • %d starts • %d wins • %d poles • %d podiums",
+		c.Seasons, c.Starts, c.Wins, c.Poles, c.Podiums)
+
+	footer := "\n" + LabelStyle.Render("Poles are starts from pole position") + "\n" + m.renderHelp()
+
+	return header +
+		LabelStyle.Render(strings.Join(facts, " • ")) + "\n" +
+		career + "\n\n" +
+		TitleStyle.Render(v.Season+" season") + "\n" +
+		m.driverProfileTbl.View() + footer
+}
+
+// driverProfileColumns are the columns of a driver's season results,
+// before fitColumns.
+func driverProfileColumns() []table.Column {
+	return []table.Column{
+		{Title: "Rd", Width: 3},
+		{Title: "Grand Prix", Width: 16},
+		{Title: "Team", Width: 16},
+		{Title: "Grid", Width: 4},
+		{Title: "Pos", Width: 4},
+		{Title: "Pts", Width: 4},
+		{Title: "Status", Width: 14},
+	}
+}
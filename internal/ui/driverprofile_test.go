@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	tests := []struct {
+		dob  string
+		now  string
+		want int
+	}{
+		{"2000-03-01", "2025-03-01", 25}, // leap year of birth, birthday today
+		{"2000-03-01", "2025-02-28", 24},
+		{"2001-03-01", "2024-03-01", 23}, // leap year now
+		{"2001-03-01", "2024-02-29", 22},
+		{"1997-09-30", "2025-10-16", 28},
+		{"1997-10-17", "2025-10-16", 27},
+		{"2000-02-29", "2025-02-28", 24},
+		{"2000-02-29", "2025-03-01", 25},
+	}
+	for _, tt := range tests {
+		now, _ := time.Parse("2006-01-02", tt.now)
+		got, ok := age(tt.dob, now)
+		if !ok || got != tt.want {
+			t.Errorf("age(%s) on %s = %d, %v; want %d", tt.dob, tt.now, got, ok, tt.want)
+		}
+	}
+
+	if _, ok := age("", time.Now()); ok {
+		t.Error("empty date of birth: want !ok")
+	}
+}
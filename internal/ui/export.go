@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/ical"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+type exportedMsg struct {
+	path     string
+	sessions int
+	err      error
+}
+
+// exportCmd writes the sessions of races, or only of round if set, to an
+// .ics file in the working directory.
+func (m Model) exportCmd(round string) tea.Cmd {
+	races := m.races
+	season := m.season
+	resultsURL := m.src.ResultsURL
+	return func() tea.Msg {
+		events, err := ical.SeasonEvents(races, round, resultsURL)
+		if err != nil {
+			return exportedMsg{err: err}
+		}
+		path := ical.FileName(season, round)
+		if err := ical.WriteFile(path, "F1 "+season, events); err != nil {
+			return exportedMsg{err: err}
+		}
+		return exportedMsg{path: path, sessions: len(events)}
+	}
+}
+
+func (m *Model) setExported(msg exportedMsg) {
+	if msg.err != nil {
+		m.notice = ErrorStyle.Render("export failed: " + msg.err.Error())
+		return
+	}
+	m.notice = LabelStyle.Render(fmt.Sprintf("exported %d sessions to %s", msg.sessions, msg.path))
+}
+
+// selectedRace is the GP shown in the schedule view.
+func (m Model) selectedRace() (models.Race, bool) {
+	if len(m.races) == 0 {
+		return models.Race{}, false
+	}
+	return m.races[m.idx], true
+}
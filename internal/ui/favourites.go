@@ -0,0 +1,25 @@
+package ui
+
+// favourites are the driver and team picked in the config file, by Ergast
+// ID, and are marked wherever they are listed.
+type favourites struct {
+	driver, team string
+}
+
+const favouriteMark = "♥ "
+
+// driverName is name, marked if id is the favourite driver.
+func (f favourites) driverName(id, name string) string {
+	if f.driver != "" && id == f.driver {
+		return favouriteMark + name
+	}
+	return name
+}
+
+// teamName is name, marked if id is the favourite team.
+func (f favourites) teamName(id, name string) string {
+	if f.team != "" && id == f.team {
+		return favouriteMark + name
+	}
+	return name
+}
@@ -0,0 +1,271 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// KeyMap is every rebindable key of the UI. Ctrl+C always quits and the
+// GP search popup keeps its own keys so typing is never intercepted.
+type KeyMap struct {
+	Quit         key.Binding
+	NextTab      key.Binding
+	PrevTab      key.Binding
+	Help         key.Binding
+	Back         key.Binding
+	Up           key.Binding
+	Down         key.Binding
+	Prev         key.Binding
+	Next         key.Binding
+	PrevSeason   key.Binding
+	NextSeason   key.Binding
+	Open         key.Binding
+	Circuit      key.Binding
+	Refresh      key.Binding
+	Timezone     key.Binding
+	Search       key.Binding
+	ExportGP     key.Binding
+	ExportSeason key.Binding
+	PitStops     key.Binding
+	Driver       key.Binding
+	Team         key.Binding
+}
+
+// action is a KeyMap field by the name used in the config file.
+type action struct {
+	name    string
+	binding func(*KeyMap) *key.Binding
+	keys    []string
+	desc    string
+}
+
+var actions = []action{
+	{"quit", func(k *KeyMap) *key.Binding { return &k.Quit }, []string{"q"}, "quit"},
+	{"next_tab", func(k *KeyMap) *key.Binding { return &k.NextTab }, []string{"tab"}, "next view"},
+	{"prev_tab", func(k *KeyMap) *key.Binding { return &k.PrevTab }, []string{"shift+tab"}, "previous view"},
+	{"help", func(k *KeyMap) *key.Binding { return &k.Help }, []string{"?"}, "help"},
+	{"back", func(k *KeyMap) *key.Binding { return &k.Back }, []string{"esc", "q", "backspace"}, "back"},
+	{"up", func(k *KeyMap) *key.Binding { return &k.Up }, []string{"up", "k"}, "up"},
+	{"down", func(k *KeyMap) *key.Binding { return &k.Down }, []string{"down", "j"}, "down"},
+	{"prev", func(k *KeyMap) *key.Binding { return &k.Prev }, []string{"left"}, "previous"},
+	{"next", func(k *KeyMap) *key.Binding { return &k.Next }, []string{"right"}, "next"},
+	{"prev_season", func(k *KeyMap) *key.Binding { return &k.PrevSeason }, []string{"["}, "previous season"},
+	{"next_season", func(k *KeyMap) *key.Binding { return &k.NextSeason }, []string{"]"}, "next season"},
+	{"open", func(k *KeyMap) *key.Binding { return &k.Open }, []string{"enter"}, "open"},
+	{"circuit", func(k *KeyMap) *key.Binding { return &k.Circuit }, []string{"c"}, "circuit"},
+	{"refresh", func(k *KeyMap) *key.Binding { return &k.Refresh }, []string{"r"}, "refresh"},
+	{"timezone", func(k *KeyMap) *key.Binding { return &k.Timezone }, []string{"z"}, "time zone"},
+	{"search", func(k *KeyMap) *key.Binding { return &k.Search }, []string{"/"}, "find GP"},
+	{"export_gp", func(k *KeyMap) *key.Binding { return &k.ExportGP }, []string{"e"}, "export GP"},
+	{"export_season", func(k *KeyMap) *key.Binding { return &k.ExportSeason }, []string{"E"}, "export season"},
+	{"pit_stops", func(k *KeyMap) *key.Binding { return &k.PitStops }, []string{"p"}, "pit stops"},
+	{"driver", func(k *KeyMap) *key.Binding { return &k.Driver }, []string{"d"}, "driver"},
+	{"team", func(k *KeyMap) *key.Binding { return &k.Team }, []string{"t"}, "team"},
+}
+
+// scopes are the actions live together in each view; a key may only be
+// bound once per scope.
+var scopes = map[string][]string{
+	"schedule":  {"quit", "next_tab", "prev_tab", "help", "up", "down", "prev", "next", "prev_season", "next_season", "open", "circuit", "refresh", "timezone", "search", "export_gp", "export_season"},
+	"calendar":  {"quit", "next_tab", "prev_tab", "help", "up", "down", "open", "prev_season", "next_season", "refresh"},
+	"standings": {"quit", "next_tab", "prev_tab", "help", "up", "down", "prev", "next", "prev_season", "next_season", "open", "team", "refresh"},
+	"results":   {"back", "help", "up", "down", "open", "pit_stops", "driver", "team"},
+	"team":      {"back", "help", "up", "down", "open", "team"},
+	"detail":    {"back", "help", "up", "down"},
+}
+
+// DefaultKeyMap is the KeyMap without user overrides.
+func DefaultKeyMap() KeyMap {
+	k, _ := NewKeyMap(nil)
+	return k
+}
+
+// NewKeyMap applies overrides, keys by action name, to the default
+// KeyMap. It fails on unknown actions and on keys bound twice in a view.
+func NewKeyMap(overrides map[string][]string) (KeyMap, error) {
+	var k KeyMap
+	known := map[string]bool{}
+	for _, a := range actions {
+		known[a.name] = true
+		keys := a.keys
+		if o, ok := overrides[a.name]; ok {
+			keys = o
+		}
+		*a.binding(&k) = key.NewBinding(key.WithKeys(keys...), key.WithHelp(keyHelp(keys), a.desc))
+	}
+
+	var errs []string
+	for name, keys := range overrides {
+		if !known[name] {
+			errs = append(errs, fmt.Sprintf("unknown action %q", name))
+		} else if len(keys) == 0 {
+			errs = append(errs, fmt.Sprintf("action %q has no keys", name))
+		}
+	}
+	for scope, names := range scopes {
+		owner := map[string]string{}
+		for _, name := range names {
+			for _, s := range k.byName(name).Keys() {
+				if other, ok := owner[s]; ok {
+					errs = append(errs, fmt.Sprintf("key %q is bound to both %q and %q in the %s view", s, other, name, scope))
+				}
+				owner[s] = name
+			}
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return KeyMap{}, fmt.Errorf("key bindings: %s", strings.Join(errs, "; "))
+	}
+	return k, nil
+}
+
+// Bindings are the keys of every action, by the names NewKeyMap takes.
+func (k KeyMap) Bindings() map[string][]string {
+	out := map[string][]string{}
+	for _, a := range actions {
+		out[a.name] = a.binding(&k).Keys()
+	}
+	return out
+}
+
+func (k *KeyMap) byName(name string) *key.Binding {
+	for _, a := range actions {
+		if a.name == name {
+			return a.binding(k)
+		}
+	}
+	return nil
+}
+
+// keyHelp renders keys for the help line, e.g. "←/h".
+func keyHelp(keys []string) string {
+	names := map[string]string{"left": "←", "right": "→", "up": "↑", "down": "↓"}
+	out := make([]string, len(keys))
+	for i, s := range keys {
+		if n, ok := names[s]; ok {
+			s = n
+		}
+		out[i] = s
+	}
+	return strings.Join(out, "/")
+}
+
+// as is b with its help text describing what it does in one view.
+func as(b key.Binding, desc string) key.Binding {
+	return key.NewBinding(key.WithKeys(b.Keys()...), key.WithHelp(b.Help().Key, desc))
+}
+
+// tableKeyMap moves table cursors with the configured up and down keys.
+func (k KeyMap) tableKeyMap() table.KeyMap {
+	km := table.DefaultKeyMap()
+	km.LineUp = k.Up
+	km.LineDown = k.Down
+	// The defaults also page with letters that views use as actions.
+	km.PageUp = key.NewBinding(key.WithKeys("pgup"))
+	km.PageDown = key.NewBinding(key.WithKeys("pgdown"))
+	km.HalfPageUp = key.NewBinding(key.WithKeys("ctrl+u"))
+	km.HalfPageDown = key.NewBinding(key.WithKeys("ctrl+d"))
+	km.GotoTop = key.NewBinding(key.WithKeys("home"))
+	km.GotoBottom = key.NewBinding(key.WithKeys("end"))
+	return km
+}
+
+// viewKeys are the keys of one view for the help line and overlay.
+type viewKeys struct {
+	short []key.Binding
+	full  [][]key.Binding
+}
+
+func (v viewKeys) ShortHelp() []key.Binding  { return v.short }
+func (v viewKeys) FullHelp() [][]key.Binding { return v.full }
+
+// keysFor lists the keys of the current view.
+func (m Model) keysFor(mode viewMode) viewKeys {
+	k := m.keys
+	move := []key.Binding{as(k.Up, "up"), as(k.Down, "down")}
+	tabs := []key.Binding{k.NextTab, k.PrevTab, k.Quit}
+
+	switch mode {
+	case viewSchedule:
+		return viewKeys{
+			short: []key.Binding{k.NextTab, as(k.Prev, "prev GP"), as(k.Next, "next GP"), as(k.Open, "results"), k.Search, k.Help, k.Quit},
+			full: [][]key.Binding{
+				append(move, as(k.Prev, "previous GP"), as(k.Next, "next GP"), k.PrevSeason, k.NextSeason, k.Search),
+				{as(k.Open, "session results"), as(k.Circuit, "toggle circuit"), k.Timezone, k.ExportGP, k.ExportSeason, k.Refresh},
+				append(tabs, k.Help),
+			},
+		}
+	case viewCalendar:
+		return viewKeys{
+			short: []key.Binding{k.NextTab, as(k.Open, "open GP"), k.PrevSeason, k.NextSeason, k.Help, k.Quit},
+			full: [][]key.Binding{
+				append(move, as(k.Open, "open GP"), k.PrevSeason, k.NextSeason, k.Refresh),
+				append(tabs, k.Help),
+			},
+		}
+	case viewDriverStandings, viewConstructorStandings:
+		open := as(k.Open, "driver profile")
+		if mode == viewConstructorStandings {
+			open = as(k.Open, "team drivers")
+		}
+		return viewKeys{
+			short: []key.Binding{k.NextTab, open, as(k.Team, "team profile"), as(k.Prev, "prev round"), as(k.Next, "next round"), k.Help, k.Quit},
+			full: [][]key.Binding{
+				append(move, as(k.Prev, "previous round"), as(k.Next, "next round"), k.PrevSeason, k.NextSeason),
+				{open, as(k.Team, "team profile"), k.Refresh},
+				append(tabs, k.Help),
+			},
+		}
+	case viewResults:
+		short := []key.Binding{as(k.Driver, "driver"), as(k.Team, "team"), k.Back, k.Help}
+		actions := []key.Binding{as(k.Driver, "driver profile"), as(k.Team, "team profile")}
+		if m.resultsView.SessionName == "Race" && !m.resultsView.Live {
+			short = append([]key.Binding{as(k.Open, "lap times"), k.PitStops}, short...)
+			actions = append([]key.Binding{as(k.Open, "lap times"), k.PitStops}, actions...)
+		}
+		return viewKeys{short: short, full: [][]key.Binding{move, actions, {k.Back, k.Help}}}
+	case viewTeamDrivers:
+		return viewKeys{
+			short: []key.Binding{as(k.Open, "driver profile"), as(k.Team, "team profile"), k.Back, k.Help},
+			full:  [][]key.Binding{move, {as(k.Open, "driver profile"), as(k.Team, "team profile")}, {k.Back, k.Help}},
+		}
+	}
+	return viewKeys{
+		short: []key.Binding{as(k.Up, "scroll"), k.Back, k.Help},
+		full:  [][]key.Binding{move, {k.Back, k.Help}},
+	}
+}
+
+// renderHelp is the short help line of the current view.
+func (m Model) renderHelp() string {
+	return m.help.ShortHelpView(m.keysFor(m.mode).ShortHelp())
+}
+
+// retryHelp tells how to get past a failed season load.
+func (m Model) retryHelp() string {
+	return fmt.Sprintf("Press %s to retry, %s/%s to change season.", m.keys.Refresh.Help().Key, m.keys.PrevSeason.Help().Key, m.keys.NextSeason.Help().Key)
+}
+
+// renderHelpOverlay lists every key of the current view, shown in place
+// of the view while help is open.
+func (m Model) renderHelpOverlay() string {
+	h := m.help
+	h.ShowAll = true
+	return TitleStyle.Render("Keys") + "\n" +
+		h.View(m.keysFor(m.mode)) + "\n\n" +
+		LabelStyle.Render(fmt.Sprintf("Ctrl+C always quits • %s or esc closes this help", m.keys.Help.Help().Key))
+}
+
+// newHelp renders help lines in the colours of the rest of the UI.
+func newHelp() help.Model {
+	h := help.New()
+	h.Styles.ShortKey = h.Styles.ShortKey.Foreground(brightGreen)
+	h.Styles.FullKey = h.Styles.FullKey.Foreground(brightGreen)
+	return h
+}
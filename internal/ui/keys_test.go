@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+func TestNewKeyMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string][]string
+		wantErr   string // substring, "" for success
+	}{
+		{"defaults", nil, ""},
+		{"vim keys", map[string][]string{"prev": {"left", "h"}, "next": {"right", "l"}}, ""},
+		{"unknown action", map[string][]string{"teleport": {"x"}}, `unknown action "teleport"`},
+		{"no keys", map[string][]string{"refresh": {}}, `action "refresh" has no keys`},
+		{"duplicate in one view", map[string][]string{"refresh": {"c"}}, `key "c" is bound to both "circuit" and "refresh" in the schedule view`},
+		{"duplicate across views", map[string][]string{"pit_stops": {"r"}}, ""},
+		{"duplicate in results", map[string][]string{"pit_stops": {"d"}}, `in the results view`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeyMap(tt.overrides)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("error %q does not contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyMapOverrides(t *testing.T) {
+	k, err := NewKeyMap(map[string][]string{"prev": {"left", "h"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Matches(keyPress("h"), k.Prev) || !key.Matches(keyPress("left"), k.Prev) {
+		t.Error("prev does not match its new keys")
+	}
+	if !key.Matches(keyPress("right"), k.Next) {
+		t.Error("next lost its default key")
+	}
+	if got := k.Bindings()["prev"]; strings.Join(got, ",") != "left,h" {
+		t.Errorf("Bindings()[prev] = %v", got)
+	}
+	if len(k.Bindings()) != len(actions) {
+		t.Errorf("Bindings has %d actions, want %d", len(k.Bindings()), len(actions))
+	}
+}
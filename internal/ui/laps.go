@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+type lapsMsg struct {
+	laps    []models.LapTime
+	fastest models.LapTime
+}
+
+type lapsErrMsg struct{ err error }
+
+func fetchLapsCmd(src api.DataSource, season, round, driverID string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		laps, err := src.DriverLaps(ctx, season, round, driverID)
+		if err != nil {
+			return lapsErrMsg{err: err}
+		}
+		// The race's fastest lap only decorates the chart; go without it.
+		fastest, _ := src.FastestLap(ctx, season, round)
+		return lapsMsg{laps: laps, fastest: fastest}
+	})
+}
+
+// selectedResult is the results table's highlighted driver, matched by the
+// position in its first column.
+func (m Model) selectedResult() (models.DriverResult, bool) {
+	row := m.resultsTbl.SelectedRow()
+	if len(row) == 0 || row[0] == "" {
+		return models.DriverResult{}, false
+	}
+	for _, r := range m.resultsView.Results {
+		if r.Position == row[0] {
+			return r, true
+		}
+	}
+	return models.DriverResult{}, false
+}
+
+// openLaps shows the lap chart of the highlighted driver of a race.
+func (m *Model) openLaps() tea.Cmd {
+	v := m.resultsView
+	if v.SessionName != "Race" || v.Loading || v.Live {
+		return nil
+	}
+	r, ok := m.selectedResult()
+	if !ok || r.DriverID == "" {
+		return nil
+	}
+
+	m.mode = viewLaps
+	m.lapsView = models.LapsView{
+		Season:   v.Season,
+		Round:    v.Round,
+		RaceName: v.RaceName,
+		DriverID: r.DriverID,
+		Driver:   r.Driver,
+		Loading:  true,
+	}
+	return m.load(viewLaps, fetchLapsCmd(m.src, v.Season, v.Round, r.DriverID))
+}
+
+func (m Model) updateLaps(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		m.mode = viewResults
+		m.lapsView = models.LapsView{}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.lapsTbl, cmd = m.lapsTbl.Update(msg)
+	return m, cmd
+}
+
+// personalBest is the driver's fastest timed lap.
+func personalBest(laps []models.LapTime) (models.LapTime, bool) {
+	var best models.LapTime
+	for _, l := range laps {
+		if l.Duration > 0 && (best.Duration == 0 || l.Duration < best.Duration) {
+			best = l
+		}
+	}
+	return best, best.Duration > 0
+}
+
+func (m *Model) setLaps(msg lapsMsg) {
+	v := &m.lapsView
+	v.Loading = false
+	v.Error = nil
+	v.Laps = msg.laps
+	v.Fastest = msg.fastest
+
+	pb, _ := personalBest(msg.laps)
+
+	rows := []table.Row{}
+	for _, l := range msg.laps {
+		delta := ""
+		if l.Duration > 0 && pb.Duration > 0 && l.Lap != pb.Lap {
+			delta = "+" + utils.FormatLapTime(l.Duration-pb.Duration)
+		}
+
+		var marks []string
+		if l.Lap == pb.Lap {
+			marks = append(marks, "PB")
+		}
+		if v.Fastest.DriverID == v.DriverID && v.Fastest.Lap == l.Lap {
+			marks = append(marks, "FASTEST")
+		}
+
+		rows = append(rows, table.Row{
+			fmt.Sprint(l.Lap),
+			l.Position,
+			l.Time,
+			delta,
+			strings.Join(marks, " "),
+		})
+	}
+	m.lapsTbl.SetRows(rows)
+	m.lapsTbl.GotoTop()
+}
+
+func (m Model) renderLapsView() string {
+	v := m.lapsView
+	header := TitleStyle.Render(fmt.Sprintf("%s %s", v.Season, v.RaceName)) + "\n" +
+		GPStyle.Render(v.Driver+" • Lap Times") + "\n"
+
+	if v.Loading {
+		return header + LabelStyle.Render("Loading laps…")
+	}
+	if v.Error != nil {
+		return header + ErrorStyle.Render(v.Error.Error()) + "\n" +
+			LabelStyle.Render("Press "+m.keys.Back.Help().Key+" to go back")
+	}
+
+	var summary string
+	if pb, ok := personalBest(v.Laps); ok {
+		summary = fmt.Sprintf("Personal best %s (lap %d)", pb.Time, pb.Lap)
+	}
+	if v.Fastest.Duration > 0 {
+		summary += fmt.Sprintf(" • Race fastest %s by %s (lap %d)", v.Fastest.Time, v.Fastest.Driver, v.Fastest.Lap)
+		if pb, ok := personalBest(v.Laps); ok && v.Fastest.DriverID != v.DriverID {
+			summary += fmt.Sprintf(", +%s", utils.FormatLapTime(pb.Duration-v.Fastest.Duration))
+		}
+	}
+
+	footer := "\n" + m.renderHelp()
+
+	return header +
+		LabelStyle.Render(summary) + "\n\n" +
+		CircuitStyle.Render(lapSparkline(v.Laps)) + "\n" +
+		LabelStyle.Render("lap times, taller is slower; pit and safety car laps are capped") + "\n\n" +
+		m.lapsTbl.View() + footer
+}
+
+// lapSparkline charts lap times from the personal best up to 7% slower
+// than a typical lap.
+func lapSparkline(laps []models.LapTime) string {
+	var secs []float64
+	for _, l := range laps {
+		secs = append(secs, l.Duration.Seconds())
+	}
+	pb, ok := personalBest(laps)
+	if !ok {
+		return ""
+	}
+	lo := pb.Duration.Seconds()
+	hi := median(secs) * 1.07
+	return sparkline(secs, lo, hi)
+}
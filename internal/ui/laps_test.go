@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+func TestLapsOfEarlierRoundIgnored(t *testing.T) {
+	src := season2024()
+	src.results["2024/2/Race"] = src.results["2024/1/Race"]
+	src.laps = map[string][]models.LapTime{
+		"2024/1/max_verstappen": {
+			{DriverID: "max_verstappen", Lap: 1, Position: "1", Time: "1:37.284", Duration: 97284 * time.Millisecond},
+			{DriverID: "max_verstappen", Lap: 2, Position: "1", Time: "1:36.961", Duration: 96961 * time.Millisecond},
+		},
+		"2024/2/max_verstappen": {
+			{DriverID: "max_verstappen", Lap: 1, Position: "1", Time: "1:34.811", Duration: 94811 * time.Millisecond},
+		},
+	}
+	m := loaded(t, src)
+	m.selectIndex(0)
+	m, cmd := update(t, m, keyPress("enter"))
+	m = run(t, m, cmd)
+
+	// Verstappen's laps of round 1, then back to the schedule and on to
+	// his laps of round 2 before round 1's have loaded.
+	m, round1 := update(t, m, keyPress("enter"))
+	m, _ = update(t, m, keyPress("esc"))
+	m, _ = update(t, m, keyPress("esc"))
+	m, _ = update(t, m, keyPress("right"))
+	m, cmd = update(t, m, keyPress("enter"))
+	m = run(t, m, cmd)
+	m, round2 := update(t, m, keyPress("enter"))
+	if m.mode != viewLaps || m.lapsView.Round != "2" || m.lapsView.DriverID != "max_verstappen" {
+		t.Fatalf("mode %v, laps of %q in round %q", m.mode, m.lapsView.DriverID, m.lapsView.Round)
+	}
+
+	m = run(t, m, round2)
+	m = run(t, m, round1)
+	if n := len(m.lapsView.Laps); n != 1 {
+		t.Fatalf("round 2's view shows %d laps, want its 1", n)
+	}
+	src.errs = map[string]error{"DriverLaps": errors.New("timeout")}
+	m = run(t, m, round1)
+	if m.lapsView.Error != nil {
+		t.Errorf("round 1's error reached round 2's view: %v", m.lapsView.Error)
+	}
+}
@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	// stackWidth is the terminal width below which the schedule view
+	// stacks its panes instead of placing them side by side.
+	stackWidth = 90
+	// scheduleLeftW is the width of the schedule view's GP pane.
+	scheduleLeftW = 42
+	// flexMin and flexMax bound the width of columns resized to fit.
+	flexMin = 8
+	flexMax = 40
+	// minTableHeight keeps a few rows on screen in tiny terminals.
+	minTableHeight = 5
+)
+
+// flexColumns are the columns fitColumns grows and shrinks; the rest are
+// short values that always fit.
+var flexColumns = map[string]bool{
+	"Driver":     true,
+	"Team":       true,
+	"Grand Prix": true,
+	"Winner":     true,
+}
+
+// fitColumns resizes the flex columns of cols, sharing out the difference
+// between width and the width cols need. Cells that no longer fit are
+// truncated with an ellipsis by the table. A width of 0, before the first
+// tea.WindowSizeMsg, leaves cols as they are.
+func fitColumns(cols []table.Column, width int) []table.Column {
+	return fitColumnsFlex(cols, width, func(c table.Column) bool { return flexColumns[c.Title] })
+}
+
+func fitColumnsFlex(cols []table.Column, width int, flex func(table.Column) bool) []table.Column {
+	if width <= 0 {
+		return cols
+	}
+
+	out := make([]table.Column, len(cols))
+	copy(out, cols)
+
+	used := 0
+	var flexIdx []int
+	for i, c := range out {
+		used += c.Width + 2 // cell padding
+		if flex(c) {
+			flexIdx = append(flexIdx, i)
+		}
+	}
+	if len(flexIdx) == 0 {
+		return out
+	}
+
+	spare := width - used
+	share, rest := spare/len(flexIdx), spare%len(flexIdx)
+	for n, i := range flexIdx {
+		w := out[i].Width + share
+		if n < rest {
+			w++
+		}
+		out[i].Width = max(flexMin, min(flexMax, w))
+	}
+	return out
+}
+
+// tableHeight is the table height that fills the terminal below and above
+// chrome lines of a view, or def before the terminal size is known.
+func (m Model) tableHeight(chrome, def int) int {
+	if m.height <= 0 {
+		return def
+	}
+	return max(minTableHeight, m.height-chrome)
+}
+
+// truncate shortens s to width cells, ending it with an ellipsis.
+func truncate(s string, width int) string {
+	return runewidth.Truncate(s, width, "…")
+}
+
+// resize fits every table to the terminal after a tea.WindowSizeMsg.
+func (m *Model) resize() {
+	w := m.width
+	m.tbl.SetColumns(m.sessionColumns())
+
+	m.resultsTbl.SetColumns(fitColumns(resultsColumns(m.resultsView), w))
+	m.resultsTbl.SetHeight(m.tableHeight(13, 20))
+
+	m.driverStandingsTbl.SetColumns(fitColumns(driverStandingsColumns(), w))
+	m.driverStandingsTbl.SetHeight(m.tableHeight(9, 20))
+	m.constructorStandingsTbl.SetColumns(fitColumns(constructorStandingsColumns(), w))
+	m.constructorStandingsTbl.SetHeight(m.tableHeight(9, 20))
+	m.teamDriversTbl.SetColumns(fitColumns(teamDriversColumns(), w))
+	m.teamDriversTbl.SetHeight(m.tableHeight(9, 10))
+
+	m.calendarTbl.SetColumns(fitColumns(calendarColumns(), w))
+	m.calendarTbl.SetHeight(m.tableHeight(9, 20))
+
+	m.lapsTbl.SetHeight(m.tableHeight(12, 15))
+
+	// The team ranking takes about 48 cells beside the pit stops table.
+	m.pitStopsTbl.SetColumns(fitColumns(pitStopsColumns(), w-48))
+	m.pitStopsTbl.SetHeight(m.tableHeight(8, 20))
+
+	m.driverProfileTbl.SetColumns(fitColumns(driverProfileColumns(), w))
+	m.driverProfileTbl.SetHeight(m.tableHeight(12, 14))
+
+	if len(m.constructorProfile.Results) > 0 {
+		m.constructorProfileTbl.SetColumns(fitColumns(constructorProfileColumns(summarizeTeamSeason(m.constructorProfile.Results)), w))
+	}
+	m.constructorProfileTbl.SetHeight(m.tableHeight(21, 12))
+}
+
+// stacked reports whether the schedule view is too narrow for side by
+// side panes.
+func (m Model) stacked() bool {
+	return m.width > 0 && m.width < stackWidth
+}
+
+// sessionColumns are the sessions table columns, the time column taking
+// whatever the GP pane leaves.
+func (m Model) sessionColumns() []table.Column {
+	title := "Local Time"
+	if len(m.races) > 0 {
+		title = m.timeColumnTitle(m.races[m.idx])
+	}
+	cols := []table.Column{
+		{Title: "Session", Width: 18},
+		{Title: title, Width: 30},
+	}
+
+	width := m.width
+	if !m.stacked() {
+		width -= scheduleLeftW + 3
+	}
+	if m.width > 0 {
+		return fitColumnsFlex(cols, width, func(c table.Column) bool { return c.Title == title })
+	}
+	return cols
+}
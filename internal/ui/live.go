@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// liveInterval is how often results of a session in progress are polled.
+const liveInterval = 5 * time.Second
+
+// liveTickMsg asks for another poll of the results view opened as
+// generation gen; ticks from views since closed are dropped.
+type liveTickMsg struct{ gen int }
+
+func liveTick(gen int) tea.Cmd {
+	return tea.Tick(liveInterval, func(time.Time) tea.Msg {
+		return liveTickMsg{gen: gen}
+	})
+}
+
+// isLive reports whether now falls in the session's Start/End window.
+func isLive(s models.UISession, now time.Time) bool {
+	return !s.Start.IsZero() && now.After(s.Start) && now.Before(s.End)
+}
+
+// sessionByKind finds the selected GP's session titled kind.
+func (m Model) sessionByKind(kind string) models.UISession {
+	for _, s := range m.uiSessions {
+		if s.Kind == kind {
+			return s
+		}
+	}
+	return models.UISession{}
+}
+
+// pollLive re-fetches the results view while its session is live. Once the
+// window closes it fetches one last time without the live cache bypass.
+func (m *Model) pollLive(msg liveTickMsg) tea.Cmd {
+	v := m.resultsView
+	if msg.gen != m.liveGen || m.mode != viewResults || !v.Live {
+		return nil
+	}
+	live := isLive(m.liveSession, time.Now())
+	if !live {
+		m.resultsView.Live = false
+	}
+	return m.fetchResultsCmd(live)
+}
+
+// goLive starts polling the open results view if its session has begun
+// since the view was opened.
+func (m *Model) goLive(now time.Time) tea.Cmd {
+	v := &m.resultsView
+	if m.mode != viewResults || v.Live || v.Loading || !isLive(m.liveSession, now) {
+		return nil
+	}
+	v.Live = true
+	v.Loading = true
+	m.liveGen++
+	return m.fetchResultsCmd(true)
+}
+
+// showingResults reports whether the results view is open on the session.
+func (m Model) showingResults(season, round, session string) bool {
+	v := m.resultsView
+	return m.mode == viewResults && season == v.Season && round == v.Round && session == v.SessionName
+}
+
+// driverKey identifies a driver across polls: by Ergast ID, else by car
+// number, with the name as a last resort.
+func driverKey(r models.DriverResult) string {
+	switch {
+	case r.DriverID != "":
+		return r.DriverID
+	case r.Number != "":
+		return "#" + r.Number
+	}
+	return r.Driver
+}
+
+// positionChanges returns, by driverKey, how many places each gained (>0)
+// or lost (<0) between two classifications.
+func positionChanges(prev, cur []models.DriverResult) map[string]int {
+	before := map[string]int{}
+	for _, r := range prev {
+		if p, err := strconv.Atoi(r.Position); err == nil {
+			before[driverKey(r)] = p
+		}
+	}
+
+	changes := map[string]int{}
+	for _, r := range cur {
+		p, err := strconv.Atoi(r.Position)
+		if err != nil {
+			continue
+		}
+		if was, ok := before[driverKey(r)]; ok && was != p {
+			changes[driverKey(r)] = was - p
+		}
+	}
+	return changes
+}
+
+func formatChange(d int) string {
+	switch {
+	case d > 0:
+		return fmt.Sprintf("▲%d", d)
+	case d < 0:
+		return fmt.Sprintf("▼%d", -d)
+	default:
+		return ""
+	}
+}
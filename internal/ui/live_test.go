@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+func TestPositionChangesByDriver(t *testing.T) {
+	// Two drivers sharing a display name, and live timing with car
+	// numbers only.
+	prev := []models.DriverResult{
+		{Position: "1", DriverID: "schumacher", Driver: "M. Schumacher"},
+		{Position: "2", DriverID: "mick_schumacher", Driver: "M. Schumacher"},
+		{Position: "3", Number: "44", Driver: "Lewis HAMILTON"},
+	}
+	cur := []models.DriverResult{
+		{Position: "1", DriverID: "mick_schumacher", Driver: "M. Schumacher"},
+		{Position: "2", Number: "44", Driver: "Sir Lewis HAMILTON"},
+		{Position: "3", DriverID: "schumacher", Driver: "M. Schumacher"},
+	}
+	got := positionChanges(prev, cur)
+	want := map[string]int{"mick_schumacher": 1, "#44": 1, "schumacher": -2}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+// resultsOpen is m with the results view open on 2024 round 1's race,
+// scheduled from start to end.
+func resultsOpen(t *testing.T, src *fakeSource, start, end time.Time) Model {
+	t.Helper()
+	m := loaded(t, src)
+	m.mode = viewResults
+	m.resultsView = models.ResultsView{Season: "2024", Round: "1", SessionName: "Race", RaceName: "Bahrain Grand Prix"}
+	m.liveSession = models.UISession{Kind: "Race", Start: start, End: end}
+	return m
+}
+
+func TestGoLiveWhileOpen(t *testing.T) {
+	now := time.Now()
+	m := resultsOpen(t, season2024(), now.Add(time.Minute), now.Add(2*time.Hour))
+	if cmd := m.goLive(now); cmd != nil || m.resultsView.Live {
+		t.Fatal("went live before the session started")
+	}
+
+	gen := m.liveGen
+	later := now.Add(2 * time.Minute)
+	cmd := m.goLive(later)
+	if cmd == nil || !m.resultsView.Live || m.liveGen == gen {
+		t.Fatalf("did not go live once the session started: live %v", m.resultsView.Live)
+	}
+	m, next := update(t, m, cmd())
+	if next == nil {
+		t.Error("no next poll scheduled")
+	}
+	if cols := m.resultsTbl.Columns(); len(cols) < 2 || cols[1].Title != "±" {
+		t.Errorf("live columns missing: %v", cols)
+	}
+	if cmd := m.goLive(later); cmd != nil {
+		t.Error("started a second poll loop")
+	}
+}
+
+func TestResultsOfOtherSeasonIgnored(t *testing.T) {
+	m := resultsOpen(t, season2024(), time.Time{}, time.Time{})
+	m.resultsView.Loading = true
+
+	results := []models.DriverResult{{Position: "1", DriverID: "max_verstappen", Driver: "Max Verstappen"}}
+	m, _ = update(t, m, resultsMsg{season: "2023", round: "1", sessionName: "Race", results: results})
+	if !m.resultsView.Loading || len(m.resultsView.Results) != 0 {
+		t.Fatal("applied 2023's results to 2024's view")
+	}
+	m, _ = update(t, m, resultsErrMsg{season: "2023", round: "1", sessionName: "Race"})
+	if !m.resultsView.Loading {
+		t.Fatal("applied 2023's error to 2024's view")
+	}
+	m, _ = update(t, m, resultsMsg{season: "2024", round: "1", sessionName: "Race", results: results})
+	if m.resultsView.Loading || len(m.resultsView.Results) != 1 {
+		t.Error("2024's results not applied")
+	}
+}
+
+func TestReopenWhileFetching(t *testing.T) {
+	src := season2024()
+	start := time.Now().UTC().Add(-30 * time.Minute)
+	r := race("2024", "1", "Bahrain Grand Prix", start.Format("2006-01-02"))
+	r.Time = start.Format("15:04:05Z")
+	src.schedules["2024"] = []models.Race{r}
+	m := loaded(t, src)
+
+	// Open the running race, go back and open it again before the first
+	// fetch has answered.
+	m, first := update(t, m, keyPress("enter"))
+	if m.mode != viewResults || !m.resultsView.Live {
+		t.Fatalf("mode %v live %v, want the live results view", m.mode, m.resultsView.Live)
+	}
+	m, _ = update(t, m, keyPress("esc"))
+	m, second := update(t, m, keyPress("enter"))
+
+	m, poll := update(t, m, first())
+	if poll != nil || !m.resultsView.Loading {
+		t.Fatal("the first view's fetch was applied and started a poll loop")
+	}
+	m, poll = update(t, m, second())
+	if poll == nil || m.resultsView.Loading || len(m.resultsView.Results) != 2 {
+		t.Errorf("the reopened view's fetch: poll %v, loading %v, %d results", poll != nil, m.resultsView.Loading, len(m.resultsView.Results))
+	}
+}
@@ -1,11 +1,12 @@
 package ui
 
 import (
-	"strconv"
 	"context"
-	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,40 +16,161 @@ import (
 	"github.com/kashifulhaque/f1-tui/internal/utils"
 )
 
+// FirstSeason is the first world championship season.
+const FirstSeason = 1950
+
 type Model struct {
-	loading       bool
-	err           error
-	season        string
-	races         []models.Race
-	idx           int
-	sessions      []models.SessionRow
-	race          models.UISession
-	tbl           table.Model
-	showCircuit   bool
-	filter        textinput.Model
+	src         api.DataSource
+	timing      api.LiveTiming
+	offline     bool
+	fetchInfo   api.FetchInfo // of the most recently loaded data
+	notice      string        // outcome of the last export, cleared by the next key
+	loading     bool
+	err         error
+	season      string
+	requested   string // season of the latest schedule request
+	races       []models.Race
+	idx         int
+	sessions    []models.SessionRow
+	race        models.UISession
+	tbl         table.Model
+	showCircuit bool
+	tz          tzMode
+	customTZ    *time.Location // from Options.Location, nil if unset
+	filter      textinput.Model
+	keys        KeyMap
+	favs        favourites
+	help        help.Model
+	showHelp    bool // the help overlay replaces the view
+
+	searchMatches []int // indexes into races matching filter, best first
+	searchCursor  int   // into searchMatches
+
+	mode viewMode
+
+	loads   int            // requests made by load
+	pending [viewCount]int // by view, the load whose answer is awaited
+
+	width, height int // of the terminal, 0 until the first tea.WindowSizeMsg
+
+	resultsView models.ResultsView
+	resultsTbl  table.Model
+	uiSessions  []models.UISession      // of the selected GP, race last
+	calendar    []utils.CalendarSession // every session of the season
+	liveSession models.UISession        // session of the open results view
+	liveGen     int                     // bumped per results view, see liveTickMsg
+
+	lapsView models.LapsView
+	lapsTbl  table.Model
+
+	pitStopsView models.PitStopsView
+	pitStopsTbl  table.Model
+
+	driverProfile    models.DriverProfileView
+	driverProfileTbl table.Model
+	profileReturn    viewMode // view a profile was opened from
+
+	constructorProfile    models.ConstructorProfileView
+	constructorProfileTbl table.Model
+
+	driverStandings    models.DriverStandingsTable
+	driverStandingsTbl table.Model
+	driverStandingsSt  standingsState
 
-	showResults   bool
-	resultsView   models.ResultsView
-	resultsTbl    table.Model
+	constructorStandings    models.ConstructorStandingsTable
+	constructorStandingsTbl table.Model
+	constructorStandingsSt  standingsState
+
+	teamDrivers    models.TeamDriversView
+	teamDriversTbl table.Model
+
+	calendarWinners calendarWinnersMsg
+	calendarTbl     table.Model
+	calendarSt      standingsState
+}
+
+type viewMode int
+
+const (
+	viewSchedule viewMode = iota
+	viewResults
+	viewDriverStandings
+	viewConstructorStandings
+	viewTeamDrivers
+	viewLaps
+	viewPitStops
+	viewDriverProfile
+	viewConstructorProfile
+	viewCalendar
+
+	viewCount // number of views, not a view
+)
+
+// tabs are the top level views cycled with tab / shift+tab.
+var tabs = []struct {
+	mode  viewMode
+	title string
+}{
+	{viewSchedule, "Schedule"},
+	{viewCalendar, "Calendar"},
+	{viewDriverStandings, "Drivers"},
+	{viewConstructorStandings, "Constructors"},
 }
 
 type dataMsg struct {
-	season string
-	races  []models.Race
+	requested string // season asked for, e.g. "current"
+	season    string // season received
+	races     []models.Race
 }
 
+// resultsMsg and resultsErrMsg answer a fetch of the results view opened
+// as generation gen, see liveGen.
 type resultsMsg struct {
-	sessionName string
-	raceName    string
-	results     []models.DriverResult
+	gen           int
+	season, round string
+	sessionName   string
+	results       []models.DriverResult
+	raceControl   string
 }
 
-type resultsErrMsg struct{ err error }
-type errMsg struct{ err error }
+type resultsErrMsg struct {
+	gen           int
+	season, round string
+	sessionName   string
+	err           error
+}
+type errMsg struct {
+	requested string
+	err       error
+}
 type refreshMsg struct{}
 type toggleCircuitMsg struct{}
 
-func InitialModel() Model {
+// Options configures the model built by InitialModel.
+type Options struct {
+	Source  api.DataSource
+	Timing  api.LiveTiming // optional, for practice and running sessions
+	Season  string         // "" or "current" follows the ongoing season
+	Offline bool           // Source only serves cached data
+
+	// Location, if set, is offered alongside local, track and UTC time
+	// and shown first. TrackTime instead starts on each circuit's zone.
+	Location  *time.Location
+	TrackTime bool
+
+	// View is the tab shown at startup, by title ("drivers"); "" is the
+	// schedule.
+	View string
+
+	// FavouriteDriver and FavouriteTeam are Ergast IDs marked wherever
+	// they are listed.
+	FavouriteDriver, FavouriteTeam string
+
+	// Keys, if set, replaces DefaultKeyMap; see NewKeyMap.
+	Keys *KeyMap
+}
+
+func InitialModel(opts Options) Model {
 	columns := []table.Column{
 		{Title: "Session", Width: 18},
 		{Title: "Local Time", Width: 30},
@@ -56,61 +178,209 @@ func InitialModel() Model {
 	t := table.New(table.WithColumns(columns), table.WithFocused(true))
 	t.SetHeight(9)
 
-	resultColumns := []table.Column{
+	resultsTbl := table.New(table.WithColumns(resultsColumns(models.ResultsView{})), table.WithFocused(true))
+	resultsTbl.SetHeight(20)
+
+	season := opts.Season
+	if season == "" {
+		season = "current"
+	}
+
+	driverStandingsTbl := table.New(table.WithColumns(driverStandingsColumns()), table.WithFocused(true))
+	driverStandingsTbl.SetHeight(20)
+
+	constructorStandingsTbl := table.New(table.WithColumns(constructorStandingsColumns()), table.WithFocused(true))
+	constructorStandingsTbl.SetHeight(20)
+
+	teamDriversTbl := table.New(table.WithColumns(teamDriversColumns()), table.WithFocused(true))
+	teamDriversTbl.SetHeight(10)
+
+	lapsTbl := table.New(table.WithColumns([]table.Column{
+		{Title: "Lap", Width: 4},
 		{Title: "Pos", Width: 4},
-		{Title: "Driver", Width: 24},
-		{Title: "Team", Width: 22},
-		{Title: "Time", Width: 16},
-		{Title: "Pts", Width: 4},
+		{Title: "Time", Width: 10},
+		{Title: "Δ PB", Width: 9},
+		{Title: "", Width: 12},
+	}), table.WithFocused(true))
+	lapsTbl.SetHeight(15)
+
+	pitStopsTbl := table.New(table.WithColumns(pitStopsColumns()), table.WithFocused(true))
+	pitStopsTbl.SetHeight(20)
+
+	driverProfileTbl := table.New(table.WithColumns(driverProfileColumns()), table.WithFocused(true))
+	driverProfileTbl.SetHeight(14)
+
+	constructorProfileTbl := table.New(table.WithFocused(true))
+	constructorProfileTbl.SetHeight(12)
+
+	calendarTbl := table.New(table.WithColumns(calendarColumns()), table.WithFocused(true))
+	calendarTbl.SetHeight(20)
+
+	keys := DefaultKeyMap()
+	if opts.Keys != nil {
+		keys = *opts.Keys
+	}
+	for _, tbl := range []*table.Model{&t, &resultsTbl, &driverStandingsTbl, &constructorStandingsTbl, &teamDriversTbl, &lapsTbl, &pitStopsTbl, &driverProfileTbl, &constructorProfileTbl, &calendarTbl} {
+		tbl.KeyMap = keys.tableKeyMap()
+	}
+
+	tz, customTZ := tzLocal, opts.Location
+	switch {
+	case customTZ == time.UTC:
+		tz, customTZ = tzUTC, nil
+	case customTZ != nil:
+		tz = tzCustom
+	case opts.TrackTime:
+		tz = tzCircuit
+	}
+
+	mode := viewSchedule
+	for _, t := range tabs {
+		if strings.EqualFold(t.title, opts.View) {
+			mode = t.mode
+		}
 	}
-	resultsTbl := table.New(table.WithColumns(resultColumns), table.WithFocused(true))
-	resultsTbl.SetHeight(20)
 
 	inp := textinput.New()
 	inp.Placeholder = "Filter by GP name…"
 	inp.Prompt = ""
 
 	return Model{
+		src:        opts.Source,
+		timing:     opts.Timing,
+		offline:    opts.Offline,
+		season:     season,
+		requested:  season,
 		loading:    true,
 		tbl:        t,
 		resultsTbl: resultsTbl,
 		filter:     inp,
+		tz:         tz,
+		keys:       keys,
+		favs:       favourites{driver: opts.FavouriteDriver, team: opts.FavouriteTeam},
+		help:       newHelp(),
+		customTZ:   customTZ,
+		mode:       mode,
+
+		driverStandingsTbl:      driverStandingsTbl,
+		constructorStandingsTbl: constructorStandingsTbl,
+		teamDriversTbl:          teamDriversTbl,
+		lapsTbl:                 lapsTbl,
+		pitStopsTbl:             pitStopsTbl,
+		driverProfileTbl:        driverProfileTbl,
+		constructorProfileTbl:   constructorProfileTbl,
+		calendarTbl:             calendarTbl,
 	}
 }
 
 func (m Model) Init() tea.Cmd {
-	return fetchCmd()
+	return tea.Batch(fetchCmd(m.src, m.season), clockTick())
+}
+
+// fetchedMsg wraps the message of a fetching command with where its data
+// came from.
+type fetchedMsg struct {
+	msg  tea.Msg
+	info api.FetchInfo
 }
 
-func fetchCmd() tea.Cmd {
+// fetching runs fn with a request timeout and reports the FetchInfo of the
+// DataSource calls it made along with its message.
+func fetching(fn func(ctx context.Context) tea.Msg) tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		races, err := api.FetchCurrentSchedule(ctx)
-		if err != nil {
-			return errMsg{err}
-		}
-		season := time.Now().Format("2006")
-		return dataMsg{season: season, races: races}
+		ctx, info := api.WithFetchInfo(ctx)
+		msg := fn(ctx)
+		return fetchedMsg{msg: msg, info: info()}
 	}
 }
 
-func fetchResultsCmd(season, round, sessionType, sessionName, raceName string) tea.Cmd {
+// loadedMsg is the answer to load request id of view.
+type loadedMsg struct {
+	view viewMode
+	id   int
+	msg  tea.Msg
+}
+
+// load runs cmd as the latest request for view's data. Answers to earlier
+// requests, such as those of a view left and reopened on another driver or
+// race, are dropped when they arrive.
+func (m *Model) load(view viewMode, cmd tea.Cmd) tea.Cmd {
+	m.loads++
+	id := m.loads
+	m.pending[view] = id
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+		return loadedMsg{view: view, id: id, msg: cmd()}
+	}
+}
+
+func fetchCmd(src api.DataSource, season string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		races, err := src.Schedule(ctx, season)
+		if err != nil {
+			return errMsg{requested: season, err: err}
+		}
+		got := season
+		if len(races) > 0 && races[0].Season != "" {
+			got = races[0].Season
+		}
+		return dataMsg{requested: season, season: got, races: races}
+	})
+}
+
+// loadSchedule fetches the schedule of the season shown. Only the answer
+// to the latest request is applied, so quickly stepping through seasons
+// cannot end on a slow, earlier one.
+func (m *Model) loadSchedule() tea.Cmd {
+	m.requested = m.season
+	m.loading = true
+	return fetchCmd(m.src, m.season)
+}
+
+// fetchResultsCmd loads the results of the open results view. Live
+// timing, when configured, covers sessions the DataSource has no
+// classification for: practice, and anything still running. Live results
+// never come from a cache entry older than a poll.
+func (m Model) fetchResultsCmd(live bool) tea.Cmd {
+	src, timing, gen := m.src, m.timing, m.liveGen
+	v, session := m.resultsView, m.liveSession
+	classified := v.SessionName == "Race" || v.SessionName == "Qualifying" || v.SessionName == "Sprint"
 
-		results, err := api.FetchSessionResults(ctx, season, round, sessionType)
+	return fetching(func(ctx context.Context) tea.Msg {
+		if live {
+			ctx = api.WithMaxAge(ctx, liveInterval)
+		}
+		if timing != nil && (live || !classified) {
+			t, err := timing.SessionTiming(ctx, v.SessionName, session.Start)
+			if err == nil {
+				return resultsMsg{
+					gen:         gen,
+					season:      v.Season,
+					round:       v.Round,
+					sessionName: v.SessionName,
+					results:     t.Results,
+					raceControl: t.LastMessage,
+				}
+			}
+			if !classified {
+				return resultsErrMsg{gen: gen, season: v.Season, round: v.Round, sessionName: v.SessionName, err: err}
+			}
+		}
+
+		results, err := src.SessionResults(ctx, v.Season, v.Round, v.SessionName)
 		if err != nil {
-			return resultsErrMsg{err}
+			return resultsErrMsg{gen: gen, season: v.Season, round: v.Round, sessionName: v.SessionName, err: err}
 		}
 		return resultsMsg{
-			sessionName: sessionName,
-			raceName:    raceName,
+			gen:         gen,
+			season:      v.Season,
+			round:       v.Round,
+			sessionName: v.SessionName,
 			results:     results,
 		}
-	}
+	})
 }
 
 func (m *Model) selectIndex(i int) {
@@ -122,41 +392,99 @@ func (m *Model) selectIndex(i int) {
 }
 
 func (m *Model) rebuild() {
-    if len(m.races) == 0 {
-        return
-    }
-
-    r := m.races[m.idx]
-    sessions, race, err := utils.BuildUISessions(r, r.Season, r.Round, api.ResultsURL)
-    if err != nil {
-        m.err = err
-        return
-    }
-
-    m.err = nil
-    m.season = r.Season
-    m.sessions = nil
-
-    allSessions := append(sessions, race)
-
-    for _, s := range allSessions {
-        m.sessions = append(m.sessions, models.SessionRow{
-            Title: s.Kind,
-            Time:  s.Start.Format("Mon 15:04 - 16:04"),
-        })
-    }
-
-    var rows []table.Row
-    for _, s := range allSessions {
-        rows = append(rows, table.Row{
-            s.Kind,
-            s.Start.Format("Jan _2 Mon 15:04") + " - " + s.End.Format("15:04"),
-        })
-    }
-
-    m.tbl.SetRows(rows)
-    m.tbl.GotoTop()
-    m.race = race
+	if len(m.races) == 0 {
+		return
+	}
+
+	r := m.races[m.idx]
+	sessions, race, err := utils.BuildUISessions(r, r.Season, r.Round, m.location(r), m.src.ResultsURL)
+	if err != nil {
+		m.err = err
+		return
+	}
+
+	m.err = nil
+	m.season = r.Season
+	m.sessions = nil
+
+	allSessions := append(sessions, race)
+	m.uiSessions = allSessions
+
+	for _, s := range allSessions {
+		m.sessions = append(m.sessions, models.SessionRow{
+			Title: s.Kind,
+			Time:  s.Start.Format("Mon 15:04 - 16:04"),
+		})
+	}
+
+	var rows []table.Row
+	for _, s := range allSessions {
+		rows = append(rows, table.Row{
+			s.Kind,
+			s.Start.Format("Jan _2 Mon 15:04") + " - " + s.End.Format("15:04"),
+		})
+	}
+
+	m.tbl.SetColumns(m.sessionColumns())
+	m.tbl.SetRows(rows)
+	m.tbl.GotoTop()
+	m.race = race
+}
+
+// switchTab moves delta tabs along tabs.
+func (m *Model) switchTab(delta int) tea.Cmd {
+	i := 0
+	for j, t := range tabs {
+		if t.mode == m.mode {
+			i = j
+		}
+	}
+	i = (i + delta + len(tabs)) % len(tabs)
+	m.mode = tabs[i].mode
+	return m.enterTab()
+}
+
+// enterTab loads the data of the tab being shown if it is not for the
+// season being shown.
+func (m *Model) enterTab() tea.Cmd {
+	switch m.mode {
+	case viewCalendar:
+		m.setCalendarRows()
+		if m.calendarSt.stale(m.season) {
+			return m.loadCalendarWinners()
+		}
+	case viewDriverStandings:
+		if m.driverStandingsSt.stale(m.season) {
+			return m.loadDriverStandings()
+		}
+	case viewConstructorStandings:
+		if m.constructorStandingsSt.stale(m.season) {
+			return m.loadConstructorStandings()
+		}
+	}
+	return nil
+}
+
+// seasonYear is the numeric season being shown, resolving "current".
+func (m Model) seasonYear() int {
+	if y, err := strconv.Atoi(m.season); err == nil {
+		return y
+	}
+	return time.Now().Year()
+}
+
+// changeSeason moves delta seasons away from the one shown, clamped to the
+// range Ergast covers, and starts fetching its schedule.
+func (m *Model) changeSeason(delta int) tea.Cmd {
+	y := m.seasonYear() + delta
+	if y < FirstSeason || y > time.Now().Year() {
+		return nil
+	}
+	m.season = strconv.Itoa(y)
+	m.races = nil
+	m.calendar = nil
+	m.idx = 0
+	return m.loadSchedule()
 }
 
 func pickRelevantIndex(races []models.Race) int {
@@ -178,23 +506,3 @@ func pickRelevantIndex(races []models.Race) int {
 	}
 	return idx
 }
-
-func filterAndSortRaces(in []models.Race) []models.Race {
-	out := make([]models.Race, 0, len(in))
-	for _, r := range in {
-		if r.RaceName == "" || r.Circuit.CircuitName == "" {
-			continue
-		}
-		out = append(out, r)
-	}
-
-	sort.Slice(out, func(i, j int) bool {
-		ri, err1 := strconv.Atoi(out[i].Round)
-		rj, err2 := strconv.Atoi(out[j].Round)
-		if err1 != nil || err2 != nil {
-			return out[i].Round < out[j].Round
-		}
-		return ri < rj
-	})
-	return out
-}
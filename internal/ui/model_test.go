@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+func season2024() *fakeSource {
+	return &fakeSource{
+		schedules: map[string][]models.Race{
+			"2024": {
+				race("2024", "2", "Saudi Arabian Grand Prix", "2024-03-09"),
+				race("2024", "1", "Bahrain Grand Prix", "2024-03-02"),
+			},
+			"2023": {race("2023", "1", "Bahrain Grand Prix", "2023-03-05")},
+		},
+		results: map[string][]models.DriverResult{
+			"2024/1/Race": {
+				{Position: "1", DriverID: "max_verstappen", Driver: "Max Verstappen", ConstructorID: "red_bull", Constructor: "Red Bull", Time: "1:31:44.742", Status: "Finished", Points: "26"},
+				{Position: "2", DriverID: "perez", Driver: "Sergio Pérez", ConstructorID: "red_bull", Constructor: "Red Bull", Time: "+22.457", Status: "Finished", Points: "18"},
+			},
+		},
+	}
+}
+
+// loaded is a model of src's 2024 season once its schedule has arrived.
+func loaded(t *testing.T, src *fakeSource) Model {
+	t.Helper()
+	m := InitialModel(Options{Source: src, Season: "2024"})
+	m, _ = update(t, m, fetchCmd(m.src, m.season)())
+	return m
+}
+
+func TestScheduleFromSource(t *testing.T) {
+	m := loaded(t, season2024())
+	if m.loading || m.err != nil {
+		t.Fatalf("loading %v, err %v", m.loading, m.err)
+	}
+	if len(m.races) != 2 || m.races[0].Round != "1" {
+		t.Fatalf("races not sorted by round: %+v", m.races)
+	}
+	if m.season != "2024" {
+		t.Errorf("season = %q, want 2024", m.season)
+	}
+
+	m.selectIndex(0)
+	if v := m.View(); !strings.Contains(v, "Bahrain Grand Prix") {
+		t.Errorf("view does not show the selected GP:\n%s", v)
+	}
+	m, _ = update(t, m, keyPress("right"))
+	if m.idx != 1 || !strings.Contains(m.View(), "Saudi Arabian Grand Prix") {
+		t.Errorf("→ did not move to round 2, idx %d", m.idx)
+	}
+}
+
+func TestScheduleError(t *testing.T) {
+	src := season2024()
+	src.errs = map[string]error{"Schedule": errors.New("network is down")}
+	m := loaded(t, src)
+	if m.err == nil || !strings.Contains(m.View(), "network is down") {
+		t.Errorf("error not shown; err %v", m.err)
+	}
+}
+
+func TestOpenRaceResults(t *testing.T) {
+	m := loaded(t, season2024())
+	m.selectIndex(0)
+
+	m, cmd := update(t, m, keyPress("enter"))
+	if m.mode != viewResults || !m.resultsView.Loading {
+		t.Fatalf("enter: mode %v, loading %v", m.mode, m.resultsView.Loading)
+	}
+	m = run(t, m, cmd)
+
+	rows := m.resultsTbl.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("got %d result rows, want 2", len(rows))
+	}
+	if !strings.Contains(strings.Join(rows[0], " "), "Max Verstappen") {
+		t.Errorf("first row %v", rows[0])
+	}
+
+	m, _ = update(t, m, keyPress("esc"))
+	if m.mode != viewSchedule {
+		t.Errorf("esc: mode %v, want the schedule", m.mode)
+	}
+}
+
+func TestLateSeasonIgnored(t *testing.T) {
+	src := season2024()
+	src.schedules["2022"] = []models.Race{race("2022", "1", "Bahrain Grand Prix", "2022-03-20")}
+	m := loaded(t, src)
+
+	m, to2023 := update(t, m, keyPress("["))
+	m, to2022 := update(t, m, keyPress("["))
+	m, back2023 := update(t, m, keyPress("]"))
+	if m.season != "2023" || !m.loading {
+		t.Fatalf("season %q loading %v, want 2023 loading", m.season, m.loading)
+	}
+
+	m = run(t, m, back2023)
+	m = run(t, m, to2022) // slow answers to earlier requests
+	m = run(t, m, to2023)
+	if m.season != "2023" || len(m.races) != 1 || m.races[0].Season != "2023" {
+		t.Errorf("showing season %q with %+v, want 2023", m.season, m.races)
+	}
+
+	src.errs = map[string]error{"Schedule": errors.New("timeout")}
+	m = run(t, m, to2022)
+	if m.err != nil {
+		t.Errorf("an earlier season's error replaced 2023: %v", m.err)
+	}
+}
+
+func TestLoadAppliesLatestRequest(t *testing.T) {
+	m := loaded(t, season2024())
+	answer := func(msg tea.Msg) tea.Cmd {
+		return func() tea.Msg { return msg }
+	}
+
+	m.lapsView = models.LapsView{Loading: true}
+	first := m.load(viewLaps, answer(lapsErrMsg{err: errors.New("timeout")}))
+	second := m.load(viewLaps, answer(lapsMsg{laps: []models.LapTime{{Lap: 1}}}))
+	pits := m.load(viewPitStops, answer(pitStopsErrMsg{err: errors.New("timeout")}))
+
+	m = run(t, m, first)
+	if m.lapsView.Error != nil || !m.lapsView.Loading {
+		t.Fatalf("an earlier request was applied: error %v", m.lapsView.Error)
+	}
+	m = run(t, m, second)
+	if m.lapsView.Loading || len(m.lapsView.Laps) != 1 {
+		t.Fatalf("the latest request was not applied: %+v", m.lapsView)
+	}
+	m = run(t, m, first)
+	if m.lapsView.Error != nil {
+		t.Errorf("an earlier request answering late was applied: %v", m.lapsView.Error)
+	}
+	m = run(t, m, pits)
+	if m.pitStopsView.Error == nil {
+		t.Error("another view's request was dropped")
+	}
+}
@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+type pitStopsMsg struct{ stops []models.PitStop }
+
+type pitStopsErrMsg struct{ err error }
+
+func fetchPitStopsCmd(src api.DataSource, season, round string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		stops, err := src.PitStops(ctx, season, round)
+		if err != nil {
+			return pitStopsErrMsg{err: err}
+		}
+		return pitStopsMsg{stops: stops}
+	})
+}
+
+// openPitStops shows the pit stops of the race in the results view.
+func (m *Model) openPitStops() tea.Cmd {
+	v := m.resultsView
+	if v.SessionName != "Race" || v.Loading || v.Live {
+		return nil
+	}
+
+	m.mode = viewPitStops
+	m.pitStopsView = models.PitStopsView{
+		Season:   v.Season,
+		Round:    v.Round,
+		RaceName: v.RaceName,
+		Loading:  true,
+	}
+	return m.load(viewPitStops, fetchPitStopsCmd(m.src, v.Season, v.Round))
+}
+
+func (m Model) updatePitStops(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		m.mode = viewResults
+		m.pitStopsView = models.PitStopsView{}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.pitStopsTbl, cmd = m.pitStopsTbl.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) setPitStops(msg pitStopsMsg) {
+	v := &m.pitStopsView
+	v.Loading = false
+	v.Error = nil
+
+	// Stops only carry driver ids; names and teams come from the results.
+	byID := map[string]models.DriverResult{}
+	order := map[string]int{}
+	for i, r := range m.resultsView.Results {
+		byID[r.DriverID] = r
+		order[r.DriverID] = i
+	}
+	stops := msg.stops
+	for i := range stops {
+		r, ok := byID[stops[i].DriverID]
+		if !ok {
+			stops[i].Driver = stops[i].DriverID
+			continue
+		}
+		stops[i].Driver = r.Driver
+		stops[i].Constructor = r.Constructor
+	}
+	sort.SliceStable(stops, func(i, j int) bool {
+		oi, oj := order[stops[i].DriverID], order[stops[j].DriverID]
+		if oi != oj {
+			return oi < oj
+		}
+		return stops[i].Stop < stops[j].Stop
+	})
+	v.Stops = stops
+
+	totals := map[string]time.Duration{}
+	for _, s := range stops {
+		totals[s.DriverID] += s.PitTime
+	}
+
+	rows := []table.Row{}
+	for i, s := range stops {
+		driver, total := "", ""
+		if i == 0 || stops[i-1].DriverID != s.DriverID {
+			driver = s.Driver
+			total = utils.FormatLapTime(totals[s.DriverID])
+		}
+		rows = append(rows, table.Row{
+			driver,
+			fmt.Sprint(s.Stop),
+			fmt.Sprint(s.Lap),
+			s.TimeOfDay,
+			s.Duration,
+			total,
+		})
+	}
+	m.pitStopsTbl.SetRows(rows)
+	m.pitStopsTbl.GotoTop()
+}
+
+// teamPitStat is a team's pit stop performance in a race.
+type teamPitStat struct {
+	team    string
+	fastest models.PitStop
+	total   time.Duration
+	count   int
+}
+
+// rankTeamsByFastestStop orders teams by their single fastest stop.
+func rankTeamsByFastestStop(stops []models.PitStop) []teamPitStat {
+	byTeam := map[string]*teamPitStat{}
+	var teams []*teamPitStat
+	for _, s := range stops {
+		if s.PitTime <= 0 || s.Constructor == "" {
+			continue
+		}
+		t, ok := byTeam[s.Constructor]
+		if !ok {
+			t = &teamPitStat{team: s.Constructor, fastest: s}
+			byTeam[s.Constructor] = t
+			teams = append(teams, t)
+		}
+		if s.PitTime < t.fastest.PitTime {
+			t.fastest = s
+		}
+		t.total += s.PitTime
+		t.count++
+	}
+
+	sort.SliceStable(teams, func(i, j int) bool {
+		return teams[i].fastest.PitTime < teams[j].fastest.PitTime
+	})
+	out := make([]teamPitStat, len(teams))
+	for i, t := range teams {
+		out[i] = *t
+	}
+	return out
+}
+
+func (m Model) renderPitStopsView() string {
+	v := m.pitStopsView
+	header := TitleStyle.Render(fmt.Sprintf("%s %s", v.Season, v.RaceName)) + "\n" +
+		GPStyle.Render("Pit Stops") + "\n"
+
+	if v.Loading {
+		return header + LabelStyle.Render("Loading pit stops…")
+	}
+	if v.Error != nil {
+		return header + ErrorStyle.Render(v.Error.Error()) + "\n" +
+			LabelStyle.Render("Press "+m.keys.Back.Help().Key+" to go back")
+	}
+
+	var ranking []string
+	ranking = append(ranking, TitleStyle.Render("Fastest stop by team"))
+	for i, t := range rankTeamsByFastestStop(v.Stops) {
+		avg := t.total / time.Duration(t.count)
+		ranking = append(ranking, fmt.Sprintf("%2d. %-16s %8s  %s (lap %d)",
+			i+1, t.team, t.fastest.Duration, lastName(t.fastest.Driver), t.fastest.Lap))
+		ranking = append(ranking, LabelStyle.Render(fmt.Sprintf("    avg %s over %d stops", utils.FormatLapTime(avg), t.count)))
+	}
+
+	footer := "\n" + LabelStyle.Render("Durations are pit lane times") + "\n" + m.renderHelp()
+
+	return header + lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		m.pitStopsTbl.View(),
+		"   ",
+		strings.Join(ranking, "\n"),
+	) + "\n" + footer
+}
+
+func lastName(full string) string {
+	if i := strings.LastIndex(full, " "); i >= 0 {
+		return full[i+1:]
+	}
+	return full
+}
+
+// pitStopsColumns are the columns of the pit stops table, before
+// fitColumns.
+func pitStopsColumns() []table.Column {
+	return []table.Column{
+		{Title: "Driver", Width: 20},
+		{Title: "#", Width: 2},
+		{Title: "Lap", Width: 4},
+		{Title: "Time", Width: 9},
+		{Title: "Duration", Width: 9},
+		{Title: "Total", Width: 9},
+	}
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+func TestPitStopsOfEarlierSeasonIgnored(t *testing.T) {
+	src := season2024()
+	src.results["2023/1/Race"] = src.results["2024/1/Race"]
+	src.pitStops = map[string][]models.PitStop{
+		"2024/1": {
+			{DriverID: "max_verstappen", Stop: 1, Lap: 17, TimeOfDay: "16:31:10", Duration: "22.451"},
+			{DriverID: "perez", Stop: 1, Lap: 18, TimeOfDay: "16:32:40", Duration: "22.903"},
+		},
+		"2023/1": {
+			{DriverID: "max_verstappen", Stop: 1, Lap: 14, TimeOfDay: "16:27:02", Duration: "21.980"},
+		},
+	}
+	m := loaded(t, src)
+	m.selectIndex(0)
+	m, cmd := update(t, m, keyPress("enter"))
+	m = run(t, m, cmd)
+	m, in2024 := update(t, m, keyPress("p"))
+
+	// Back to the schedule and on to round 1 of 2023 before 2024's stops
+	// have loaded.
+	m, _ = update(t, m, keyPress("esc"))
+	m, _ = update(t, m, keyPress("esc"))
+	m, cmd = update(t, m, keyPress("["))
+	m = run(t, m, cmd)
+	m, cmd = update(t, m, keyPress("enter"))
+	m = run(t, m, cmd)
+	m, in2023 := update(t, m, keyPress("p"))
+	if m.mode != viewPitStops || m.pitStopsView.Season != "2023" || m.pitStopsView.Round != "1" {
+		t.Fatalf("mode %v, pit stops of %s round %s", m.mode, m.pitStopsView.Season, m.pitStopsView.Round)
+	}
+
+	m = run(t, m, in2023)
+	m = run(t, m, in2024)
+	if n := len(m.pitStopsView.Stops); n != 1 {
+		t.Fatalf("2023's view shows %d stops, want its 1", n)
+	}
+	src.errs = map[string]error{"PitStops": errors.New("timeout")}
+	m = run(t, m, in2024)
+	if m.pitStopsView.Error != nil {
+		t.Errorf("2024's error reached 2023's view: %v", m.pitStopsView.Error)
+	}
+}
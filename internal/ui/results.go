@@ -0,0 +1,312 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// qualifyingBreakdown reports whether v is a qualifying classification
+// with per segment times, shown with Q1/Q2/Q3 columns.
+func qualifyingBreakdown(v models.ResultsView) bool {
+	if v.SessionName != "Qualifying" {
+		return false
+	}
+	for _, r := range v.Results {
+		if r.Q1 != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// raceBreakdown reports whether v is a race or sprint classification with
+// grid and lap data, shown with the post-race analysis columns.
+func raceBreakdown(v models.ResultsView) bool {
+	if v.Live || (v.SessionName != "Race" && v.SessionName != "Sprint") {
+		return false
+	}
+	for _, r := range v.Results {
+		if r.Grid != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// resultsColumns are the results table columns for v: a position change
+// column while live, and segment times and gaps for qualifying.
+func resultsColumns(v models.ResultsView) []table.Column {
+	if qualifyingBreakdown(v) {
+		return []table.Column{
+			{Title: "Pos", Width: 4},
+			{Title: "Driver", Width: 22},
+			{Title: "Team", Width: 16},
+			{Title: "Q1", Width: 9},
+			{Title: "Q2", Width: 9},
+			{Title: "Q3", Width: 9},
+			{Title: "Gap", Width: 8},
+			{Title: "To Cut", Width: 8},
+		}
+	}
+	if raceBreakdown(v) {
+		return []table.Column{
+			{Title: "Pos", Width: 3},
+			{Title: "No", Width: 3},
+			{Title: "Driver", Width: 20},
+			{Title: "Team", Width: 16},
+			{Title: "Grid", Width: 4},
+			{Title: "+/-", Width: 4},
+			{Title: "Laps", Width: 4},
+			{Title: "Time/Status", Width: 12},
+			{Title: "Best Lap", Width: 10},
+			{Title: "Rk", Width: 3},
+			{Title: "Pts", Width: 4},
+		}
+	}
+
+	cols := []table.Column{
+		{Title: "Pos", Width: 4},
+		{Title: "Driver", Width: 24},
+		{Title: "Team", Width: 22},
+		{Title: "Time", Width: 16},
+		{Title: "Pts", Width: 4},
+	}
+	if v.Live {
+		cols = append(cols[:1], append([]table.Column{{Title: "±", Width: 4}}, cols[1:]...)...)
+	}
+	return cols
+}
+
+func resultsRows(v models.ResultsView, fav favourites) []table.Row {
+	if qualifyingBreakdown(v) {
+		return qualifyingRows(v.Results, fav)
+	}
+	if raceBreakdown(v) {
+		return raceRows(v.Results, fav)
+	}
+
+	rows := []table.Row{}
+	for _, res := range v.Results {
+		row := table.Row{
+			res.Position,
+			fav.driverName(res.DriverID, res.Driver),
+			fav.teamName(res.ConstructorID, res.Constructor),
+			res.Time,
+			res.Points,
+		}
+		if v.Live {
+			row = append(row[:1], append(table.Row{formatChange(v.Changes[driverKey(res)])}, row[1:]...)...)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// qualifyingCutoffs returns how many drivers made Q2 and Q3, or zero for
+// segments the results have no times for. Q3 has had ten cars since 2006
+// and Q1 and Q2 knock out the rest in two equal halves.
+func qualifyingCutoffs(results []models.DriverResult) (q2, q3 int) {
+	var hasQ2, hasQ3 bool
+	for _, r := range results {
+		hasQ2 = hasQ2 || r.Q2 != ""
+		hasQ3 = hasQ3 || r.Q3 != ""
+	}
+	if hasQ3 {
+		q3 = 10
+	}
+	if hasQ2 {
+		q2 = 10 + (len(results)-10+1)/2
+	}
+	return q2, q3
+}
+
+// segmentTime is the lap time a driver set in segment (1-3), 0 if none.
+func segmentTime(r models.DriverResult, segment int) time.Duration {
+	s := [...]string{r.Q1, r.Q2, r.Q3}[segment-1]
+	d, err := utils.ParseLapTime(s)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// cutoffTime is the time needed to get through segment: the slowest time
+// set in it by a driver who made it out, i.e. placed in the top through.
+func cutoffTime(results []models.DriverResult, segment, through int) time.Duration {
+	var cut time.Duration
+	for i, r := range results {
+		if i >= through {
+			break
+		}
+		cut = max(cut, segmentTime(r, segment))
+	}
+	return cut
+}
+
+// firstOutTime is the fastest time in segment among the drivers knocked
+// out of it.
+func firstOutTime(results []models.DriverResult, segment, through int) time.Duration {
+	var first time.Duration
+	for i, r := range results {
+		if i < through {
+			continue
+		}
+		if t := segmentTime(r, segment); t > 0 && (first == 0 || t < first) {
+			first = t
+		}
+	}
+	return first
+}
+
+func formatGap(d time.Duration) string {
+	if d < 0 {
+		return "-" + utils.FormatLapTime(-d)
+	}
+	return "+" + utils.FormatLapTime(d)
+}
+
+// qualifyingRows lays out Q1/Q2/Q3 with each driver's gap to pole and to
+// the cutoff of the segment they went out in, with divider rows at the
+// elimination lines. Drivers who made Q3 show their Q2 margin over the
+// first driver knocked out.
+func qualifyingRows(results []models.DriverResult, fav favourites) []table.Row {
+	q2, q3 := qualifyingCutoffs(results)
+
+	var pole time.Duration
+	if len(results) > 0 {
+		r := results[0]
+		pole = segmentTime(r, 3)
+		if pole == 0 {
+			pole = segmentTime(r, 2)
+		}
+		if pole == 0 {
+			pole = segmentTime(r, 1)
+		}
+	}
+
+	cut1, cut2 := cutoffTime(results, 1, q2), cutoffTime(results, 2, q3)
+	firstOut2 := firstOutTime(results, 2, q3)
+
+	rows := []table.Row{}
+	for i, r := range results {
+		if (i == q3 && q3 > 0) || (i == q2 && q2 > 0) {
+			segment := "Q2"
+			if i == q2 {
+				segment = "Q1"
+			}
+			rows = append(rows, table.Row{"", strings.Repeat("─", 8) + " " + segment + " cut", "", "", "", "", "", ""})
+		}
+
+		final, segment := time.Duration(0), 0
+		for s := 3; s >= 1 && final == 0; s-- {
+			final, segment = segmentTime(r, s), s
+		}
+
+		gap := "-"
+		if i == 0 && final > 0 {
+			gap = "POLE"
+		} else if final > 0 && pole > 0 {
+			gap = formatGap(final - pole)
+		}
+
+		toCut := "-"
+		switch {
+		case q2 > 0 && i >= q2:
+			if t := segmentTime(r, 1); t > 0 && cut1 > 0 {
+				toCut = formatGap(t - cut1)
+			}
+		case q3 > 0 && i >= q3:
+			if t := segmentTime(r, 2); t > 0 && cut2 > 0 {
+				toCut = formatGap(t - cut2)
+			}
+		case q3 > 0 && segment >= 2:
+			if t := segmentTime(r, 2); t > 0 && firstOut2 > 0 {
+				toCut = formatGap(t - firstOut2)
+			}
+		}
+
+		rows = append(rows, table.Row{
+			r.Position,
+			fav.driverName(r.DriverID, r.Driver),
+			fav.teamName(r.ConstructorID, r.Constructor),
+			r.Q1,
+			r.Q2,
+			r.Q3,
+			gap,
+			toCut,
+		})
+	}
+	return rows
+}
+
+// positionsGained is grid minus finishing position, counting a pit lane
+// start as starting last. ok is false for unclassified finishers, whose
+// PositionText is a letter while Position still counts them.
+func positionsGained(r models.DriverResult, starters int) (int, bool) {
+	if _, err := strconv.Atoi(r.PositionText); r.PositionText != "" && err != nil {
+		return 0, false
+	}
+	pos, err := strconv.Atoi(r.Position)
+	if err != nil {
+		return 0, false
+	}
+	grid, err := strconv.Atoi(r.Grid)
+	if err != nil {
+		return 0, false
+	}
+	if grid == 0 {
+		grid = starters
+	}
+	return grid - pos, true
+}
+
+// raceRows lays out a race for post-race analysis. The fastest lap holder
+// is marked with a ★ next to their lap.
+func raceRows(results []models.DriverResult, fav favourites) []table.Row {
+	rows := []table.Row{}
+	for _, r := range results {
+		grid := r.Grid
+		if grid == "0" {
+			grid = "PL"
+		}
+
+		gained := ""
+		if d, ok := positionsGained(r, len(results)); ok {
+			gained = formatChange(d)
+			if d == 0 {
+				gained = "="
+			}
+		}
+
+		t := r.Time
+		if t == "" || t == "-" {
+			t = r.Status
+		}
+
+		best := r.FastestLap
+		if r.FastestLapRank == "1" {
+			best += " ★"
+		}
+
+		rows = append(rows, table.Row{
+			r.Position,
+			r.Number,
+			fav.driverName(r.DriverID, r.Driver),
+			fav.teamName(r.ConstructorID, r.Constructor),
+			grid,
+			gained,
+			r.Laps,
+			t,
+			best,
+			r.FastestLapRank,
+			r.Points,
+		})
+	}
+	return rows
+}
@@ -0,0 +1,23 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+func TestPositionsGained(t *testing.T) {
+	results := []models.DriverResult{
+		{Position: "1", PositionText: "1", Driver: "Lando Norris", Grid: "3", Status: "Finished"},
+		{Position: "2", PositionText: "2", Driver: "Max Verstappen", Grid: "0", Status: "Finished"},
+		{Position: "3", PositionText: "3", Driver: "George Russell", Grid: "3", Status: "Finished"},
+		{Position: "4", PositionText: "R", Driver: "Liam Lawson", Grid: "18", Status: "Retired"},
+		{Position: "5", Driver: "Oscar Piastri", Grid: "5", Status: "Finished"},
+	}
+	want := []string{"▲2", "▲3", "=", "", "="}
+	for i, row := range raceRows(results, favourites{}) {
+		if got := row[5]; got != want[i] {
+			t.Errorf("%s: +/- %q, want %q", results[i].Driver, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// searchRows is how many matches the search popup lists at once.
+const searchRows = 8
+
+// raceSource is a fuzzy.Source over the searchable text of each GP.
+type raceSource []models.Race
+
+func (s raceSource) String(i int) string {
+	r := s[i]
+	return strings.Join([]string{r.RaceName, r.Circuit.CircuitName, r.Circuit.Location.Locality, r.Circuit.Location.Country}, " ")
+}
+
+func (s raceSource) Len() int { return len(s) }
+
+// openSearch focuses the GP filter with every round listed.
+func (m *Model) openSearch() tea.Cmd {
+	m.filter.SetValue("")
+	m.filterSearch()
+	return m.filter.Focus()
+}
+
+// filterSearch matches the filter against the season, best match first,
+// or lists every round while the filter is empty.
+func (m *Model) filterSearch() {
+	m.searchMatches = m.searchMatches[:0]
+	m.searchCursor = 0
+	if m.filter.Value() == "" {
+		for i := range m.races {
+			m.searchMatches = append(m.searchMatches, i)
+		}
+		return
+	}
+	for _, match := range fuzzy.FindFrom(m.filter.Value(), raceSource(m.races)) {
+		m.searchMatches = append(m.searchMatches, match.Index)
+	}
+}
+
+// updateSearch handles keys while the GP filter is focused.
+func (m Model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.filter.Blur()
+		return m, nil
+	case "enter":
+		if len(m.searchMatches) > 0 {
+			m.selectIndex(m.searchMatches[m.searchCursor])
+		}
+		m.filter.Blur()
+		return m, nil
+	case "up", "ctrl+p":
+		if m.searchCursor > 0 {
+			m.searchCursor--
+		}
+		return m, nil
+	case "down", "ctrl+n":
+		if m.searchCursor < len(m.searchMatches)-1 {
+			m.searchCursor++
+		}
+		return m, nil
+	}
+
+	before := m.filter.Value()
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	if m.filter.Value() != before {
+		m.filterSearch()
+	}
+	return m, cmd
+}
+
+// renderSearch is the search popup shown in place of the sessions table.
+func (m Model) renderSearch() string {
+	lines := []string{"/ " + m.filter.View(), ""}
+	if len(m.searchMatches) == 0 {
+		lines = append(lines, LabelStyle.Render("no matching GP"))
+	}
+
+	// Scroll the list so the cursor stays in view.
+	start := max(0, m.searchCursor-searchRows+1)
+	end := min(len(m.searchMatches), start+searchRows)
+	for i := start; i < end; i++ {
+		r := m.races[m.searchMatches[i]]
+		flag := ""
+		if code := utils.CountryNameToCode(r.Circuit.Location.Country, r.RaceName, r.Circuit.CircuitName); code != "" {
+			flag = utils.CountryCodeToFlag(code) + " "
+		}
+		line := fmt.Sprintf("%2s  %s%s", r.Round, flag, r.RaceName)
+		if i == m.searchCursor {
+			line = ActiveTabStyle.Render(line)
+		} else {
+			line = " " + line + " "
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "", LabelStyle.Render("↑/↓ choose • Enter go to GP • ESC cancel"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(brightGreen).
+		Padding(0, 1).
+		Render(strings.Join(lines, "\n"))
+}
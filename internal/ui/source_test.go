@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// fakeSource is a DataSource serving fixtures from memory. A method with
+// an entry in errs fails with it instead.
+type fakeSource struct {
+	schedules            map[string][]models.Race                    // by season
+	results              map[string][]models.DriverResult            // by "season/round/session"
+	driverStandings      map[string]models.DriverStandingsTable      // by "season/round", "" the latest
+	constructorStandings map[string]models.ConstructorStandingsTable // by "season/round"
+	teamResults          map[string][]models.SeasonResult            // by constructor ID
+	laps                 map[string][]models.LapTime                 // by "season/round/driverID"
+	pitStops             map[string][]models.PitStop                 // by "season/round"
+	errs                 map[string]error                            // by method name
+}
+
+var _ api.DataSource = (*fakeSource)(nil)
+
+func (f *fakeSource) fail(method string) error {
+	return f.errs[method]
+}
+
+func (f *fakeSource) Schedule(ctx context.Context, season string) ([]models.Race, error) {
+	if err := f.fail("Schedule"); err != nil {
+		return nil, err
+	}
+	races, ok := f.schedules[season]
+	if !ok {
+		return nil, fmt.Errorf("no season %s", season)
+	}
+	return races, nil
+}
+
+func (f *fakeSource) SessionResults(ctx context.Context, season, round, sessionType string) ([]models.DriverResult, error) {
+	if err := f.fail("SessionResults"); err != nil {
+		return nil, err
+	}
+	results, ok := f.results[season+"/"+round+"/"+sessionType]
+	if !ok {
+		return nil, fmt.Errorf("no results available yet")
+	}
+	return results, nil
+}
+
+func (f *fakeSource) DriverStandings(ctx context.Context, season, round string) (models.DriverStandingsTable, error) {
+	if err := f.fail("DriverStandings"); err != nil {
+		return models.DriverStandingsTable{}, err
+	}
+	return f.driverStandings[season+"/"+round], nil
+}
+
+func (f *fakeSource) ConstructorStandings(ctx context.Context, season, round string) (models.ConstructorStandingsTable, error) {
+	if err := f.fail("ConstructorStandings"); err != nil {
+		return models.ConstructorStandingsTable{}, err
+	}
+	if table, ok := f.constructorStandings[season+"/"+round]; ok {
+		return table, nil
+	}
+	return models.ConstructorStandingsTable{Season: season}, nil
+}
+
+func (f *fakeSource) DriverLaps(ctx context.Context, season, round, driverID string) ([]models.LapTime, error) {
+	if err := f.fail("DriverLaps"); err != nil {
+		return nil, err
+	}
+	return f.laps[season+"/"+round+"/"+driverID], nil
+}
+
+func (f *fakeSource) FastestLap(ctx context.Context, season, round string) (models.LapTime, error) {
+	return models.LapTime{}, f.fail("FastestLap")
+}
+
+func (f *fakeSource) PitStops(ctx context.Context, season, round string) ([]models.PitStop, error) {
+	if err := f.fail("PitStops"); err != nil {
+		return nil, err
+	}
+	return f.pitStops[season+"/"+round], nil
+}
+
+func (f *fakeSource) Driver(ctx context.Context, driverID string) (models.Driver, error) {
+	return models.Driver{DriverID: driverID}, f.fail("Driver")
+}
+
+func (f *fakeSource) DriverSeasonResults(ctx context.Context, season, driverID string) ([]models.SeasonResult, error) {
+	return nil, f.fail("DriverSeasonResults")
+}
+
+func (f *fakeSource) DriverCareer(ctx context.Context, driverID string) (models.CareerStats, error) {
+	return models.CareerStats{}, f.fail("DriverCareer")
+}
+
+func (f *fakeSource) Constructor(ctx context.Context, constructorID string) (models.Constructor, error) {
+	return models.Constructor{ConstructorID: constructorID}, f.fail("Constructor")
+}
+
+func (f *fakeSource) ConstructorSeasonResults(ctx context.Context, season, constructorID string) ([]models.SeasonResult, error) {
+	if err := f.fail("ConstructorSeasonResults"); err != nil {
+		return nil, err
+	}
+	return f.teamResults[constructorID], nil
+}
+
+func (f *fakeSource) RaceWinners(ctx context.Context, season string) ([]models.SeasonResult, error) {
+	return nil, f.fail("RaceWinners")
+}
+
+func (f *fakeSource) ResultsURL(season, round string) string {
+	return "https://example.com/" + season + "/" + round
+}
+
+// race is a GP of season fixture on date, racing at 14:00 UTC.
+func race(season, round, name, date string) models.Race {
+	r := models.Race{Season: season, Round: round, RaceName: name, Date: date, Time: "14:00:00Z"}
+	r.Circuit.CircuitID = "circuit_" + round
+	r.Circuit.CircuitName = name + " Circuit"
+	r.Circuit.Location.Country = "Italy"
+	return r
+}
+
+// update feeds msg to m.
+func update(t *testing.T, m Model, msg tea.Msg) (Model, tea.Cmd) {
+	t.Helper()
+	next, cmd := m.Update(msg)
+	return next.(Model), cmd
+}
+
+// run executes cmd, which must not be a tick or a batch, and feeds its
+// message to m.
+func run(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("no command to run")
+	}
+	m, _ = update(t, m, cmd())
+	return m
+}
+
+// runBatch executes the commands of cmd, a tea.Batch, in order and feeds
+// their messages to m.
+func runBatch(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok {
+		t.Fatal("not a batch")
+	}
+	for _, c := range batch {
+		if c != nil {
+			m = run(t, m, c)
+		}
+	}
+	return m
+}
+
+func keyPress(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "left":
+		return tea.KeyMsg{Type: tea.KeyLeft}
+	case "right":
+		return tea.KeyMsg{Type: tea.KeyRight}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
@@ -0,0 +1,452 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kashifulhaque/f1-tui/internal/api"
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// standingsState tracks what a standings tab shows or is fetching.
+type standingsState struct {
+	season  string // season of the data shown or being fetched
+	round   string // requested round, "" for the latest
+	latest  string // round of the latest standings, once known
+	loading bool
+	err     error
+}
+
+// stale reports whether the tab needs fetching for season.
+func (st standingsState) stale(season string) bool {
+	return st.season != season && !st.loading
+}
+
+// stepRound moves the requested round by delta; "" (latest) is the upper
+// bound. It reports whether the round changed.
+func (st *standingsState) stepRound(delta int, shown string) bool {
+	cur, err := strconv.Atoi(shown)
+	if err != nil {
+		return false
+	}
+	latest, err := strconv.Atoi(st.latest)
+	if err != nil {
+		latest = cur
+	}
+
+	next := cur + delta
+	switch {
+	case next < 1:
+		return false
+	case next >= latest:
+		if st.round == "" {
+			return false
+		}
+		st.round = ""
+	default:
+		st.round = strconv.Itoa(next)
+	}
+	return true
+}
+
+type driverStandingsMsg struct {
+	requested string
+	table     models.DriverStandingsTable
+}
+
+type standingsErrMsg struct {
+	mode viewMode
+	err  error
+}
+
+func fetchDriverStandingsCmd(src api.DataSource, season, round string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		table, err := src.DriverStandings(ctx, season, round)
+		if err != nil {
+			return standingsErrMsg{mode: viewDriverStandings, err: err}
+		}
+		return driverStandingsMsg{requested: round, table: table}
+	})
+}
+
+func (m *Model) loadDriverStandings() tea.Cmd {
+	m.driverStandingsSt.season = m.season
+	m.driverStandingsSt.loading = true
+	m.driverStandingsSt.err = nil
+	return m.load(viewDriverStandings, fetchDriverStandingsCmd(m.src, m.season, m.driverStandingsSt.round))
+}
+
+type constructorStandingsMsg struct {
+	requested string
+	table     models.ConstructorStandingsTable
+}
+
+func fetchConstructorStandingsCmd(src api.DataSource, season, round string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		table, err := src.ConstructorStandings(ctx, season, round)
+		if err != nil {
+			return standingsErrMsg{mode: viewConstructorStandings, err: err}
+		}
+		return constructorStandingsMsg{requested: round, table: table}
+	})
+}
+
+func (m *Model) loadConstructorStandings() tea.Cmd {
+	m.constructorStandingsSt.season = m.season
+	m.constructorStandingsSt.loading = true
+	m.constructorStandingsSt.err = nil
+	return m.load(viewConstructorStandings, fetchConstructorStandingsCmd(m.src, m.season, m.constructorStandingsSt.round))
+}
+
+type teamDriversMsg struct{ drivers []models.DriverStanding }
+
+type teamDriversErrMsg struct{ err error }
+
+// fetchTeamDriversCmd lists the drivers who scored for constructorID in the
+// drivers' championship after round.
+func fetchTeamDriversCmd(src api.DataSource, season, round, constructorID string) tea.Cmd {
+	return fetching(func(ctx context.Context) tea.Msg {
+		table, err := src.DriverStandings(ctx, season, round)
+		if err != nil {
+			return teamDriversErrMsg{err: err}
+		}
+
+		var drivers []models.DriverStanding
+		for _, d := range table.Standings {
+			if slices.Contains(d.ConstructorIDs, constructorID) {
+				drivers = append(drivers, d)
+			}
+		}
+		return teamDriversMsg{drivers: drivers}
+	})
+}
+
+// updateStandingsKeys handles the keys shared by the standings tabs: tab
+// switching, stepping through rounds and seasons, and refreshing.
+func (m *Model) updateStandingsKeys(msg tea.KeyMsg, st *standingsState, shownRound string, load func() tea.Cmd) (tea.Cmd, bool) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return tea.Quit, true
+	case key.Matches(msg, m.keys.NextTab):
+		return m.switchTab(1), true
+	case key.Matches(msg, m.keys.PrevTab):
+		return m.switchTab(-1), true
+	case key.Matches(msg, m.keys.Prev):
+		if st.stepRound(-1, shownRound) {
+			return load(), true
+		}
+		return nil, true
+	case key.Matches(msg, m.keys.Next):
+		if st.stepRound(1, shownRound) {
+			return load(), true
+		}
+		return nil, true
+	case key.Matches(msg, m.keys.Refresh):
+		return load(), true
+	case key.Matches(msg, m.keys.PrevSeason, m.keys.NextSeason):
+		delta := 1
+		if key.Matches(msg, m.keys.PrevSeason) {
+			delta = -1
+		}
+		cmd := m.changeSeason(delta)
+		if cmd == nil {
+			return nil, true
+		}
+		st.round = ""
+		st.latest = ""
+		return tea.Batch(cmd, load()), true
+	}
+	return nil, false
+}
+
+func (m Model) updateDriverStandings(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.Open) {
+		i := m.driverStandingsTbl.Cursor()
+		if m.driverStandingsSt.loading || i < 0 || i >= len(m.driverStandings.Standings) {
+			return m, nil
+		}
+		d := m.driverStandings.Standings[i]
+		return m, m.openDriverProfile(m.driverStandings.Season, d.DriverID, d.Driver)
+	}
+	if key.Matches(msg, m.keys.Team) {
+		i := m.driverStandingsTbl.Cursor()
+		if m.driverStandingsSt.loading || i < 0 || i >= len(m.driverStandings.Standings) {
+			return m, nil
+		}
+		d := m.driverStandings.Standings[i]
+		if n := len(d.ConstructorIDs); n > 0 {
+			return m, m.openConstructorProfile(m.driverStandings.Season, d.ConstructorIDs[n-1], d.Constructor)
+		}
+		return m, nil
+	}
+
+	if cmd, ok := m.updateStandingsKeys(msg, &m.driverStandingsSt, m.driverStandings.Round, m.loadDriverStandings); ok {
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.driverStandingsTbl, cmd = m.driverStandingsTbl.Update(msg)
+	return m, cmd
+}
+
+func (m Model) updateConstructorStandings(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.Open) {
+		i := m.constructorStandingsTbl.Cursor()
+		if m.constructorStandingsSt.loading || i < 0 || i >= len(m.constructorStandings.Standings) {
+			return m, nil
+		}
+		team := m.constructorStandings.Standings[i]
+		m.mode = viewTeamDrivers
+		m.teamDrivers = models.TeamDriversView{
+			Team:    team,
+			Season:  m.constructorStandings.Season,
+			Round:   m.constructorStandings.Round,
+			Loading: true,
+		}
+		return m, m.load(viewTeamDrivers, fetchTeamDriversCmd(m.src, m.teamDrivers.Season, m.teamDrivers.Round, team.ConstructorID))
+	}
+	if key.Matches(msg, m.keys.Team) {
+		i := m.constructorStandingsTbl.Cursor()
+		if m.constructorStandingsSt.loading || i < 0 || i >= len(m.constructorStandings.Standings) {
+			return m, nil
+		}
+		team := m.constructorStandings.Standings[i]
+		return m, m.openConstructorProfile(m.constructorStandings.Season, team.ConstructorID, team.Constructor)
+	}
+
+	if cmd, ok := m.updateStandingsKeys(msg, &m.constructorStandingsSt, m.constructorStandings.Round, m.loadConstructorStandings); ok {
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.constructorStandingsTbl, cmd = m.constructorStandingsTbl.Update(msg)
+	return m, cmd
+}
+
+func (m Model) updateTeamDrivers(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		m.mode = viewConstructorStandings
+		m.teamDrivers = models.TeamDriversView{}
+		return m, nil
+	case key.Matches(msg, m.keys.Open):
+		i := m.teamDriversTbl.Cursor()
+		if m.teamDrivers.Loading || i < 0 || i >= len(m.teamDrivers.Drivers) {
+			return m, nil
+		}
+		d := m.teamDrivers.Drivers[i]
+		return m, m.openDriverProfile(m.teamDrivers.Season, d.DriverID, d.Driver)
+	case key.Matches(msg, m.keys.Team):
+		t := m.teamDrivers.Team
+		return m, m.openConstructorProfile(m.teamDrivers.Season, t.ConstructorID, t.Constructor)
+	}
+
+	var cmd tea.Cmd
+	m.teamDriversTbl, cmd = m.teamDriversTbl.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) setDriverStandings(msg driverStandingsMsg) {
+	m.driverStandingsSt.loading = false
+	m.driverStandingsSt.err = nil
+	if msg.table.Season != "" {
+		m.driverStandingsSt.season = msg.table.Season
+	}
+	if msg.requested == "" {
+		m.driverStandingsSt.latest = msg.table.Round
+	}
+	m.driverStandings = msg.table
+
+	rows := []table.Row{}
+	for _, s := range msg.table.Standings {
+		flag := ""
+		if code := utils.NationalityToCode(s.Nationality); code != "" {
+			flag = utils.CountryCodeToFlag(code)
+		}
+		team := ""
+		if n := len(s.ConstructorIDs); n > 0 {
+			team = s.ConstructorIDs[n-1]
+		}
+		rows = append(rows, table.Row{
+			s.Position,
+			flag,
+			m.favs.driverName(s.DriverID, s.Driver),
+			m.favs.teamName(team, s.Constructor),
+			s.Wins,
+			s.Points,
+		})
+	}
+	m.driverStandingsTbl.SetRows(rows)
+	m.driverStandingsTbl.GotoTop()
+}
+
+func (m *Model) setConstructorStandings(msg constructorStandingsMsg) {
+	m.constructorStandingsSt.loading = false
+	m.constructorStandingsSt.err = nil
+	if msg.table.Season != "" {
+		m.constructorStandingsSt.season = msg.table.Season
+	}
+	if msg.requested == "" {
+		m.constructorStandingsSt.latest = msg.table.Round
+	}
+	m.constructorStandings = msg.table
+
+	rows := []table.Row{}
+	for _, s := range msg.table.Standings {
+		flag := ""
+		if code := utils.NationalityToCode(s.Nationality); code != "" {
+			flag = utils.CountryCodeToFlag(code)
+		}
+		rows = append(rows, table.Row{
+			s.Position,
+			flag,
+			m.favs.teamName(s.ConstructorID, s.Constructor),
+			s.Wins,
+			s.Points,
+		})
+	}
+	m.constructorStandingsTbl.SetRows(rows)
+	m.constructorStandingsTbl.GotoTop()
+}
+
+func (m *Model) setTeamDrivers(msg teamDriversMsg) {
+	m.teamDrivers.Loading = false
+	m.teamDrivers.Error = nil
+	m.teamDrivers.Drivers = msg.drivers
+
+	teamPts, _ := strconv.ParseFloat(m.teamDrivers.Team.Points, 64)
+
+	rows := []table.Row{}
+	for _, d := range msg.drivers {
+		share := "-"
+		if pts, err := strconv.ParseFloat(d.Points, 64); err == nil && teamPts > 0 {
+			share = fmt.Sprintf("%.0f%%", 100*pts/teamPts)
+		}
+		rows = append(rows, table.Row{
+			d.Position,
+			m.favs.driverName(d.DriverID, d.Driver),
+			d.Wins,
+			d.Points,
+			share,
+		})
+	}
+	m.teamDriversTbl.SetRows(rows)
+	m.teamDriversTbl.GotoTop()
+}
+
+func (m Model) renderDriverStandingsView() string {
+	st := m.driverStandingsSt
+	header := m.renderTabs() + "\n\n"
+
+	if st.loading {
+		return header + LabelStyle.Render(fmt.Sprintf("Loading %s driver standings…", st.season))
+	}
+	if st.err != nil {
+		return header + ErrorStyle.Render(st.err.Error()) + "\n" +
+			LabelStyle.Render(m.retryHelp())
+	}
+
+	t := m.driverStandings
+	title := fmt.Sprintf("%s Drivers' Championship", t.Season)
+	after := "after round " + t.Round
+	if st.round == "" {
+		after += " (latest)"
+	}
+
+	footer := "\n" + m.renderHelp()
+
+	return header +
+		GPStyle.Render(title) + "\n" +
+		LabelStyle.Render(after) + "\n\n" +
+		m.driverStandingsTbl.View() + footer
+}
+
+func (m Model) renderConstructorStandingsView() string {
+	st := m.constructorStandingsSt
+	header := m.renderTabs() + "\n\n"
+
+	if st.loading {
+		return header + LabelStyle.Render(fmt.Sprintf("Loading %s constructor standings…", st.season))
+	}
+	if st.err != nil {
+		return header + ErrorStyle.Render(st.err.Error()) + "\n" +
+			LabelStyle.Render(m.retryHelp())
+	}
+
+	t := m.constructorStandings
+	title := fmt.Sprintf("%s Constructors' Championship", t.Season)
+	after := "after round " + t.Round
+	if st.round == "" {
+		after += " (latest)"
+	}
+
+	footer := "\n" + m.renderHelp()
+
+	return header +
+		GPStyle.Render(title) + "\n" +
+		LabelStyle.Render(after) + "\n\n" +
+		m.constructorStandingsTbl.View() + footer
+}
+
+func (m Model) renderTeamDriversView() string {
+	v := m.teamDrivers
+	header := TitleStyle.Render(fmt.Sprintf("%s %s", v.Season, v.Team.Constructor)) + "\n" +
+		GPStyle.Render(fmt.Sprintf("P%s • %s pts • %s wins • after round %s", v.Team.Position, v.Team.Points, v.Team.Wins, v.Round)) + "\n"
+
+	if v.Loading {
+		return header + LabelStyle.Render("Loading drivers…")
+	}
+	if v.Error != nil {
+		return header + ErrorStyle.Render(v.Error.Error()) + "\n" +
+			LabelStyle.Render("Press "+m.keys.Back.Help().Key+" to go back")
+	}
+
+	note := LabelStyle.Render("Driver points are season totals, including any scored for other teams.")
+	footer := "\n" + m.renderHelp()
+
+	return header + m.teamDriversTbl.View() + "\n" + note + footer
+}
+
+// driverStandingsColumns are the columns of the drivers' standings, before
+// fitColumns.
+func driverStandingsColumns() []table.Column {
+	return []table.Column{
+		{Title: "Pos", Width: 4},
+		{Title: "", Width: 4},
+		{Title: "Driver", Width: 24},
+		{Title: "Team", Width: 22},
+		{Title: "Wins", Width: 5},
+		{Title: "Pts", Width: 6},
+	}
+}
+
+// constructorStandingsColumns are the columns of the constructors'
+// standings, before fitColumns.
+func constructorStandingsColumns() []table.Column {
+	return []table.Column{
+		{Title: "Pos", Width: 4},
+		{Title: "", Width: 4},
+		{Title: "Team", Width: 28},
+		{Title: "Wins", Width: 5},
+		{Title: "Pts", Width: 6},
+	}
+}
+
+// teamDriversColumns are the columns of the team drivers table, before
+// fitColumns.
+func teamDriversColumns() []table.Column {
+	return []table.Column{
+		{Title: "Pos", Width: 4},
+		{Title: "Driver", Width: 24},
+		{Title: "Wins", Width: 5},
+		{Title: "Pts", Width: 6},
+		{Title: "Share", Width: 6},
+	}
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// standings2024 is season2024 with standings after rounds 4 and 5, the
+// latest.
+func standings2024() *fakeSource {
+	src := season2024()
+	after := func(round, maxPts, perezPts string) models.DriverStandingsTable {
+		return models.DriverStandingsTable{Season: "2024", Round: round, Standings: []models.DriverStanding{
+			{Position: "1", DriverID: "max_verstappen", Driver: "Max Verstappen", ConstructorIDs: []string{"red_bull"}, Points: maxPts},
+			{Position: "2", DriverID: "perez", Driver: "Sergio Pérez", ConstructorIDs: []string{"red_bull"}, Points: perezPts},
+		}}
+	}
+	src.driverStandings = map[string]models.DriverStandingsTable{
+		"2024/":  after("5", "110", "85"),
+		"2024/5": after("5", "110", "85"),
+		"2024/4": after("4", "77", "64"),
+	}
+	team := func(round, pts string) models.ConstructorStandingsTable {
+		return models.ConstructorStandingsTable{Season: "2024", Round: round, Standings: []models.ConstructorStanding{
+			{Position: "1", ConstructorID: "red_bull", Constructor: "Red Bull", Points: pts, Wins: "4"},
+		}}
+	}
+	src.constructorStandings = map[string]models.ConstructorStandingsTable{
+		"2024/":  team("5", "195"),
+		"2024/4": team("4", "141"),
+	}
+	return src
+}
+
+func TestTeamDriversOfEarlierRoundIgnored(t *testing.T) {
+	src := standings2024()
+	m := loaded(t, src)
+	m.mode = viewConstructorStandings
+	m = run(t, m, m.enterTab())
+
+	// Red Bull after round 5, then back, a round earlier and Red Bull
+	// again before round 5's drivers have loaded.
+	m, round5 := update(t, m, keyPress("enter"))
+	m, _ = update(t, m, keyPress("esc"))
+	m, cmd := update(t, m, keyPress("left"))
+	m = run(t, m, cmd)
+	m, round4 := update(t, m, keyPress("enter"))
+	if m.mode != viewTeamDrivers || m.teamDrivers.Round != "4" {
+		t.Fatalf("mode %v, team drivers after round %q", m.mode, m.teamDrivers.Round)
+	}
+
+	m = run(t, m, round4)
+	m = run(t, m, round5)
+	if d := m.teamDrivers.Drivers; len(d) != 2 || d[0].Points != "77" {
+		t.Fatalf("round 4's view shows %+v", d)
+	}
+	src.errs = map[string]error{"DriverStandings": errors.New("timeout")}
+	m = run(t, m, round5)
+	if m.teamDrivers.Error != nil {
+		t.Errorf("round 5's error reached round 4's view: %v", m.teamDrivers.Error)
+	}
+}
+
+func TestStandingsOfEarlierSeasonIgnored(t *testing.T) {
+	src := standings2024()
+	src.schedules["2022"] = []models.Race{race("2022", "1", "Bahrain Grand Prix", "2022-03-20")}
+	src.driverStandings["2023/"] = models.DriverStandingsTable{Season: "2023", Round: "22", Standings: []models.DriverStanding{
+		{Position: "1", DriverID: "max_verstappen", Driver: "Max Verstappen", Points: "575"},
+	}}
+	src.driverStandings["2022/"] = models.DriverStandingsTable{Season: "2022", Round: "22", Standings: []models.DriverStanding{
+		{Position: "1", DriverID: "max_verstappen", Driver: "Max Verstappen", Points: "454"},
+	}}
+	m := loaded(t, src)
+	m.mode = viewDriverStandings
+	m = run(t, m, m.enterTab())
+
+	// Two seasons back, with 2023's answers arriving after 2022's.
+	m, to2023 := update(t, m, keyPress("["))
+	m, to2022 := update(t, m, keyPress("["))
+	m = runBatch(t, m, to2022)
+	m = runBatch(t, m, to2023)
+	if m.driverStandingsSt.season != "2022" || m.driverStandings.Season != "2022" {
+		t.Fatalf("showing %s standings as %s, want 2022", m.driverStandings.Season, m.driverStandingsSt.season)
+	}
+
+	src.errs = map[string]error{"DriverStandings": errors.New("timeout")}
+	m = runBatch(t, m, to2023)
+	if m.driverStandingsSt.err != nil {
+		t.Errorf("2023's error replaced 2022's standings: %v", m.driverStandingsSt.err)
+	}
+}
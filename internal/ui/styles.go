@@ -1,23 +1,124 @@
 package ui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// palette is the set of colours a theme is made of.
+type palette struct {
+	accent  lipgloss.TerminalColor // highlights, badges and borders
+	bg      lipgloss.TerminalColor // text on accent backgrounds
+	muted   lipgloss.TerminalColor // secondary text
+	text    lipgloss.TerminalColor // primary text
+	card    lipgloss.TerminalColor // card background
+	errorFg lipgloss.TerminalColor
+	staleFg lipgloss.TerminalColor
+}
+
+// themes are the palettes SetTheme accepts.
+var themes = map[string]palette{
+	"default": {
+		accent:  lipgloss.Color("#00FF87"),
+		bg:      lipgloss.Color("#0A0E1A"),
+		muted:   lipgloss.Color("#8B95A5"),
+		text:    lipgloss.Color("#FFFFFF"),
+		card:    lipgloss.Color("#1A3A2E"),
+		errorFg: lipgloss.Color("#FF6B6B"),
+		staleFg: lipgloss.Color("#FFB86C"),
+	},
+	// light suits terminals with a light background.
+	"light": {
+		accent:  lipgloss.Color("#00875A"),
+		bg:      lipgloss.Color("#FFFFFF"),
+		muted:   lipgloss.Color("#5C6370"),
+		text:    lipgloss.Color("#1B1F23"),
+		card:    lipgloss.Color("#E3F5EC"),
+		errorFg: lipgloss.Color("#C62828"),
+		staleFg: lipgloss.Color("#B35900"),
+	},
+	// mono leaves colours to the terminal, relying on bold and reverse
+	// video.
+	"mono": {
+		accent:  lipgloss.NoColor{},
+		bg:      lipgloss.NoColor{},
+		muted:   lipgloss.NoColor{},
+		text:    lipgloss.NoColor{},
+		card:    lipgloss.NoColor{},
+		errorFg: lipgloss.NoColor{},
+		staleFg: lipgloss.NoColor{},
+	},
+}
 
 var (
-	brightGreen = lipgloss.Color("#00FF87")  // Bright green for highlights
-	darkBg      = lipgloss.Color("#0A0E1A")  // Very dark background
-	mediumGray  = lipgloss.Color("#8B95A5")  // Muted gray for secondary text
-	white       = lipgloss.Color("#FFFFFF")  // White for primary text
-	darkGreen   = lipgloss.Color("#1A3A2E")  // Dark green for cards/borders
+	brightGreen lipgloss.TerminalColor // Bright green for highlights
+	darkBg      lipgloss.TerminalColor // Very dark background
+	mediumGray  lipgloss.TerminalColor // Muted gray for secondary text
+	white       lipgloss.TerminalColor // White for primary text
+	darkGreen   lipgloss.TerminalColor // Dark green for cards/borders
 )
 
 var (
-	TitleStyle    = lipgloss.NewStyle().Bold(true).Foreground(brightGreen).MarginBottom(1)
-	GPStyle       = lipgloss.NewStyle().Bold(true).Foreground(white).MarginBottom(1)
-	CardStyle     = lipgloss.NewStyle().Padding(1, 2).Border(lipgloss.RoundedBorder()).BorderForeground(brightGreen).Background(darkGreen)
-	LabelStyle    = lipgloss.NewStyle().Foreground(mediumGray)
-	LiveBadge     = lipgloss.NewStyle().Bold(true).Foreground(darkBg).Background(brightGreen).Padding(0, 1)
-	ErrorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B"))
-	RoundBadge    = lipgloss.NewStyle().Bold(true).Foreground(darkBg).Background(brightGreen).Padding(0, 1)
-
-	CircuitStyle  = lipgloss.NewStyle().Foreground(brightGreen)
+	TitleStyle   lipgloss.Style
+	GPStyle      lipgloss.Style
+	CardStyle    lipgloss.Style
+	LabelStyle   lipgloss.Style
+	LiveBadge    lipgloss.Style
+	ErrorStyle   lipgloss.Style
+	RoundBadge   lipgloss.Style
+	CircuitStyle lipgloss.Style
+
+	StaleBadge lipgloss.Style
+	StaleStyle lipgloss.Style
+
+	TabStyle       lipgloss.Style
+	ActiveTabStyle lipgloss.Style
 )
+
+func init() {
+	_ = SetTheme("default")
+}
+
+// ThemeNames lists the themes SetTheme accepts.
+func ThemeNames() []string {
+	var names []string
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetTheme switches every style to the named theme. It must be called
+// before InitialModel.
+func SetTheme(name string) error {
+	p, ok := themes[name]
+	if !ok {
+		return fmt.Errorf("theme %q: want one of %s", name, strings.Join(ThemeNames(), ", "))
+	}
+	brightGreen, darkBg, mediumGray, white, darkGreen = p.accent, p.bg, p.muted, p.text, p.card
+
+	// Badges fall back to reverse video where the theme has no colours.
+	_, mono := p.accent.(lipgloss.NoColor)
+	badge := lipgloss.NewStyle().Bold(true).Foreground(darkBg).Background(brightGreen).Padding(0, 1).Reverse(mono)
+
+	TitleStyle = lipgloss.NewStyle().Bold(true).Foreground(brightGreen).MarginBottom(1)
+	GPStyle = lipgloss.NewStyle().Bold(true).Foreground(white).MarginBottom(1)
+	CardStyle = lipgloss.NewStyle().Padding(1, 2).Border(lipgloss.RoundedBorder()).BorderForeground(brightGreen).Background(darkGreen)
+	LabelStyle = lipgloss.NewStyle().Foreground(mediumGray).Faint(mono)
+	LiveBadge = badge
+	ErrorStyle = lipgloss.NewStyle().Foreground(p.errorFg).Bold(mono)
+	RoundBadge = badge
+
+	CircuitStyle = lipgloss.NewStyle().Foreground(brightGreen)
+
+	StaleBadge = badge.Background(p.staleFg)
+	StaleStyle = lipgloss.NewStyle().Foreground(p.staleFg)
+
+	TabStyle = lipgloss.NewStyle().Foreground(mediumGray).Padding(0, 1)
+	ActiveTabStyle = badge
+	return nil
+}
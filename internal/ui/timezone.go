@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
+)
+
+// tzMode is the zone session times are shown in, cycled with z.
+type tzMode int
+
+const (
+	tzLocal   tzMode = iota
+	tzCircuit        // the track's own zone
+	tzUTC
+	tzCustom // from --tz, only offered when set
+)
+
+// location is the zone to show the sessions of r in.
+func (m Model) location(r models.Race) *time.Location {
+	switch m.tz {
+	case tzCircuit:
+		return utils.CircuitLocation(r.Circuit)
+	case tzUTC:
+		return time.UTC
+	case tzCustom:
+		if m.customTZ != nil {
+			return m.customTZ
+		}
+	}
+	return time.Local
+}
+
+// cycleTimezone moves to the next zone mode, skipping tzCustom when no
+// zone was given.
+func (m *Model) cycleTimezone() {
+	m.tz++
+	if m.tz > tzCustom || (m.tz == tzCustom && m.customTZ == nil) {
+		m.tz = tzLocal
+	}
+	cursor := m.tbl.Cursor()
+	m.rebuild()
+	m.tbl.SetCursor(cursor)
+}
+
+// timeColumnTitle names the zone of the sessions table, e.g.
+// "Track Time (Asia/Tokyo)".
+func (m Model) timeColumnTitle(r models.Race) string {
+	loc := m.location(r)
+	switch m.tz {
+	case tzCircuit:
+		return "Track Time (" + loc.String() + ")"
+	case tzUTC:
+		return "UTC"
+	case tzCustom:
+		return loc.String()
+	}
+	return "Local Time (" + zoneName(loc) + ")"
+}
+
+// zoneName is loc's name, or its abbreviation for the anonymous "Local".
+func zoneName(loc *time.Location) string {
+	if loc.String() != "Local" {
+		return loc.String()
+	}
+	name, _ := time.Now().In(loc).Zone()
+	return name
+}
@@ -2,111 +2,332 @@ package ui
 
 import (
 	"errors"
+	"time"
 
-	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/kashifulhaque/f1-tui/internal/models"
+	"github.com/kashifulhaque/f1-tui/internal/utils"
 )
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+		return m, nil
+
 	case tea.KeyMsg:
-		s := msg.String()
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		m.notice = ""
+
+		if m.showHelp {
+			if key.Matches(msg, m.keys.Help) || msg.String() == "esc" {
+				m.showHelp = false
+			}
+			return m, nil
+		}
+		if m.mode == viewSchedule && m.filter.Focused() {
+			return m.updateSearch(msg)
+		}
+		if key.Matches(msg, m.keys.Help) {
+			m.showHelp = true
+			return m, nil
+		}
 
-		if m.showResults {
-			switch s {
-			case "esc", "q", "backspace":
-				m.showResults = false
+		switch m.mode {
+		case viewResults:
+			switch {
+			case key.Matches(msg, m.keys.Back):
+				m.mode = viewSchedule
 				m.resultsView = models.ResultsView{}
 				return m, nil
-			case "ctrl+c":
-				return m, tea.Quit
+			case key.Matches(msg, m.keys.Open):
+				return m, m.openLaps()
+			case key.Matches(msg, m.keys.PitStops):
+				return m, m.openPitStops()
+			case key.Matches(msg, m.keys.Driver):
+				if r, ok := m.selectedResult(); ok {
+					return m, m.openDriverProfile(m.resultsView.Season, r.DriverID, r.Driver)
+				}
+				return m, nil
+			case key.Matches(msg, m.keys.Team):
+				if r, ok := m.selectedResult(); ok {
+					return m, m.openConstructorProfile(m.resultsView.Season, r.ConstructorID, r.Constructor)
+				}
+				return m, nil
 			}
 			var cmd tea.Cmd
 			m.resultsTbl, cmd = m.resultsTbl.Update(msg)
 			return m, cmd
+		case viewCalendar:
+			return m.updateCalendar(msg)
+		case viewDriverStandings:
+			return m.updateDriverStandings(msg)
+		case viewConstructorStandings:
+			return m.updateConstructorStandings(msg)
+		case viewTeamDrivers:
+			return m.updateTeamDrivers(msg)
+		case viewLaps:
+			return m.updateLaps(msg)
+		case viewPitStops:
+			return m.updatePitStops(msg)
+		case viewDriverProfile:
+			return m.updateDriverProfile(msg)
+		case viewConstructorProfile:
+			return m.updateConstructorProfile(msg)
 		}
 
-		switch s {
-		case "ctrl+c", "q":
+		switch {
+		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
-		case "left":
+		case key.Matches(msg, m.keys.Search):
+			if len(m.races) > 0 {
+				return m, m.openSearch()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.NextTab):
+			return m, m.switchTab(1)
+		case key.Matches(msg, m.keys.PrevTab):
+			return m, m.switchTab(-1)
+		case key.Matches(msg, m.keys.Prev):
 			m.selectIndex(m.idx - 1)
 			return m, nil
-		case "right":
+		case key.Matches(msg, m.keys.Next):
 			m.selectIndex(m.idx + 1)
 			return m, nil
-		case "enter":
+		case key.Matches(msg, m.keys.Open):
 			if m.tbl.Focused() && len(m.races) > 0 {
 				selectedRow := m.tbl.SelectedRow()
 				if len(selectedRow) > 0 {
 					sessionName := selectedRow[0]
 					r := m.races[m.idx]
 
-					m.showResults = true
+					session := m.sessionByKind(sessionName)
+					live := isLive(session, time.Now())
+
+					m.mode = viewResults
 					m.resultsView = models.ResultsView{
+						Season:      r.Season,
+						Round:       r.Round,
 						SessionName: sessionName,
 						RaceName:    r.RaceName,
 						Loading:     true,
+						Live:        live,
 					}
+					m.liveSession = session
+					m.liveGen++
+					m.resultsTbl.SetRows(nil)
+					m.resultsTbl.SetColumns(fitColumns(resultsColumns(m.resultsView), m.width))
 
-					return m, fetchResultsCmd(r.Season, r.Round, sessionName, sessionName, r.RaceName)
+					return m, m.fetchResultsCmd(live)
 				}
 			}
 			return m, nil
-		case "c":
+		case key.Matches(msg, m.keys.Circuit):
 			m.showCircuit = !m.showCircuit
 			return m, nil
-		case "r":
-			m.loading = true
-			return m, fetchCmd()
+		case key.Matches(msg, m.keys.Timezone):
+			m.cycleTimezone()
+			return m, nil
+		case key.Matches(msg, m.keys.ExportGP):
+			if r, ok := m.selectedRace(); ok {
+				return m, m.exportCmd(r.Round)
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.ExportSeason):
+			if len(m.races) > 0 {
+				return m, m.exportCmd("")
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.Refresh):
+			return m, m.loadSchedule()
+		case key.Matches(msg, m.keys.PrevSeason):
+			return m, m.changeSeason(-1)
+		case key.Matches(msg, m.keys.NextSeason):
+			return m, m.changeSeason(1)
+		}
+
+	case loadedMsg:
+		if msg.id != m.pending[msg.view] {
+			return m, nil
 		}
+		return m.Update(msg.msg)
+
+	case fetchedMsg:
+		if !msg.info.FetchedAt.IsZero() {
+			m.fetchInfo = msg.info
+		}
+		return m.Update(msg.msg)
 
 	case resultsMsg:
-	    m.resultsView.Loading = false
-	    m.resultsView.Results = msg.results
-	    m.resultsView.SessionName = msg.sessionName
-	    m.resultsView.RaceName = msg.raceName
-
-	    rows := []table.Row{}
-	    for _, res := range msg.results {
-	        rows = append(rows, table.Row{
-	            res.Position,
-	            res.Driver,
-	            res.Constructor,
-	            res.Time,
-	            res.Points,
-	        })
-	    }
-	    m.resultsTbl.SetRows(rows)
-	    m.resultsTbl.GotoTop()
-	    return m, nil
+		if msg.gen != m.liveGen || !m.showingResults(msg.season, msg.round, msg.sessionName) {
+			return m, nil
+		}
+		v := &m.resultsView
+		refresh := len(v.Results) > 0
+		if v.Live && refresh {
+			v.Changes = positionChanges(v.Results, msg.results)
+		}
+		v.Loading = false
+		v.Error = nil
+		v.Results = msg.results
+		v.RaceControl = msg.raceControl
+		v.UpdatedAt = time.Now()
+
+		m.resultsTbl.SetRows(nil)
+		m.resultsTbl.SetColumns(fitColumns(resultsColumns(*v), m.width))
+		m.resultsTbl.SetRows(resultsRows(*v, m.favs))
+		if !refresh {
+			m.resultsTbl.GotoTop()
+		}
+		if v.Live {
+			return m, liveTick(m.liveGen)
+		}
+		return m, nil
 
 	case resultsErrMsg:
+		if msg.gen != m.liveGen || !m.showingResults(msg.season, msg.round, msg.sessionName) {
+			return m, nil
+		}
 		m.resultsView.Loading = false
+		if m.resultsView.Live {
+			// Keep the last classification on screen and try again.
+			if len(m.resultsView.Results) == 0 {
+				m.resultsView.Error = msg.err
+			}
+			return m, liveTick(m.liveGen)
+		}
 		m.resultsView.Error = msg.err
 		return m, nil
 
+	case lapsMsg:
+		m.setLaps(msg)
+		return m, nil
+
+	case lapsErrMsg:
+		m.lapsView.Loading = false
+		m.lapsView.Error = msg.err
+		return m, nil
+
+	case pitStopsMsg:
+		m.setPitStops(msg)
+		return m, nil
+
+	case pitStopsErrMsg:
+		m.pitStopsView.Loading = false
+		m.pitStopsView.Error = msg.err
+		return m, nil
+
+	case driverProfileMsg:
+		m.setDriverProfile(msg)
+		return m, nil
+
+	case driverProfileErrMsg:
+		if msg.driverID == m.driverProfile.Driver.DriverID {
+			m.driverProfile.Loading = false
+			m.driverProfile.Error = msg.err
+		}
+		return m, nil
+
+	case constructorProfileMsg:
+		m.setConstructorProfile(msg)
+		return m, nil
+
+	case constructorProfileErrMsg:
+		if msg.constructorID == m.constructorProfile.Constructor.ConstructorID {
+			m.constructorProfile.Loading = false
+			m.constructorProfile.Error = msg.err
+		}
+		return m, nil
+
+	case calendarWinnersMsg:
+		m.setCalendarWinners(msg)
+		return m, nil
+
+	case calendarErrMsg:
+		if msg.season == m.calendarSt.season {
+			m.calendarSt.loading = false
+			m.calendarSt.err = msg.err
+		}
+		return m, nil
+
+	case exportedMsg:
+		m.setExported(msg)
+		return m, nil
+
+	case clockTickMsg:
+		return m, tea.Batch(clockTick(), m.goLive(time.Now()))
+
+	case liveTickMsg:
+		return m, m.pollLive(msg)
+
+	case driverStandingsMsg:
+		m.setDriverStandings(msg)
+		return m, nil
+
+	case standingsErrMsg:
+		switch msg.mode {
+		case viewDriverStandings:
+			m.driverStandingsSt.loading = false
+			m.driverStandingsSt.err = msg.err
+		case viewConstructorStandings:
+			m.constructorStandingsSt.loading = false
+			m.constructorStandingsSt.err = msg.err
+		}
+		return m, nil
+
+	case constructorStandingsMsg:
+		m.setConstructorStandings(msg)
+		return m, nil
+
+	case teamDriversMsg:
+		m.setTeamDrivers(msg)
+		return m, nil
+
+	case teamDriversErrMsg:
+		m.teamDrivers.Loading = false
+		m.teamDrivers.Error = msg.err
+		return m, nil
+
 	case dataMsg:
+		if msg.requested != m.requested {
+			return m, nil
+		}
 		m.loading = false
 		m.err = nil
-		m.races = filterAndSortRaces(msg.races)
+		m.season = msg.season
+		m.races = utils.SortRaces(msg.races)
+		m.calendar = utils.BuildCalendar(m.races, nil)
 		if len(m.races) == 0 {
 			m.err = errors.New("no races in season")
 			return m, nil
 		}
 		m.idx = pickRelevantIndex(m.races)
 		m.rebuild()
-		return m, nil
+		m.setCalendarRows()
+		// A tab opened before the season was known, e.g. at startup,
+		// loads now.
+		return m, m.enterTab()
 
 	case errMsg:
+		if msg.requested != m.requested {
+			return m, nil
+		}
 		m.loading = false
 		m.err = msg.err
 		return m, nil
 	}
 
 	var cmd tea.Cmd
+	if m.filter.Focused() {
+		// Cursor blinks of the GP filter.
+		m.filter, cmd = m.filter.Update(msg)
+		return m, cmd
+	}
 	m.tbl, cmd = m.tbl.Update(msg)
 	return m, cmd
 }
@@ -10,16 +10,70 @@ import (
 )
 
 func (m Model) View() string {
-	if m.showResults {
-		return m.renderResultsView()
+	var out string
+	switch m.mode {
+	case viewResults:
+		out = m.renderResultsView()
+	case viewDriverStandings:
+		out = m.renderDriverStandingsView()
+	case viewConstructorStandings:
+		out = m.renderConstructorStandingsView()
+	case viewTeamDrivers:
+		out = m.renderTeamDriversView()
+	case viewLaps:
+		out = m.renderLapsView()
+	case viewPitStops:
+		out = m.renderPitStopsView()
+	case viewDriverProfile:
+		out = m.renderDriverProfileView()
+	case viewConstructorProfile:
+		out = m.renderConstructorProfileView()
+	case viewCalendar:
+		out = m.renderCalendarView()
+	default:
+		out = m.renderScheduleView()
 	}
 
+	if m.showHelp {
+		out = m.renderHelpOverlay()
+	}
+
+	if status := m.renderStatus(); status != "" {
+		out += "\n" + status
+	}
+	if m.width > 0 {
+		// Wrap help lines and long messages rather than let the terminal
+		// break them mid-word.
+		out = lipgloss.NewStyle().Width(m.width).Render(out)
+	}
+	return out
+}
+
+// renderStatus flags offline mode and data served from the cache past its
+// TTL, after the outcome of the last export.
+func (m Model) renderStatus() string {
+	var parts []string
+	if m.notice != "" {
+		parts = append(parts, m.notice)
+	}
+	if m.offline {
+		parts = append(parts, StaleBadge.Render("OFFLINE"))
+	}
+	if fi := m.fetchInfo; fi.Stale {
+		parts = append(parts, StaleStyle.Render("⚠ showing cached data from "+utils.HumanizeAge(time.Since(fi.FetchedAt))+" ago"))
+	} else if fi.FromCache && m.offline {
+		parts = append(parts, LabelStyle.Render("cached "+utils.HumanizeAge(time.Since(fi.FetchedAt))+" ago"))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (m Model) renderScheduleView() string {
 	if m.loading {
-		return TitleStyle.Render("F1 TUI") + "\n" + LabelStyle.Render("Loading schedule…")
+		return TitleStyle.Render("F1 TUI") + "\n" + LabelStyle.Render(fmt.Sprintf("Loading %s schedule…", m.season))
 	}
 
 	if m.err != nil {
-		return TitleStyle.Render("F1 TUI") + "\n" + ErrorStyle.Render(m.err.Error()) + "\nPress r to retry."
+		return TitleStyle.Render("F1 TUI") + "\n" + ErrorStyle.Render(m.err.Error()) + "\n" + m.retryHelp()
 	}
 
 	if len(m.races) == 0 {
@@ -28,6 +82,11 @@ func (m Model) View() string {
 
 	r := m.races[m.idx]
 
+	leftW := scheduleLeftW
+	if m.stacked() {
+		leftW = m.width
+	}
+
 	// Left pane
 	countryCode := utils.CountryNameToCode(
 		r.Circuit.Location.Country,
@@ -39,10 +98,9 @@ func (m Model) View() string {
 		flag = utils.CountryCodeToFlag(countryCode) + " "
 	}
 
-	left := RoundBadge.Render(fmt.Sprintf("ROUND %s", r.Round)) + "\n" +
-			GPStyle.Render(fmt.Sprintf("%s%s", flag, r.RaceName)) + "\n" +
-			LabelStyle.Render(fmt.Sprintf("%s", r.Circuit.CircuitName)) + "\n"
-
+	left := RoundBadge.Render(fmt.Sprintf("%s · ROUND %s", r.Season, r.Round)) + "\n" +
+		GPStyle.Render(truncate(fmt.Sprintf("%s%s", flag, r.RaceName), leftW-2)) + "\n" +
+		LabelStyle.Render(truncate(r.Circuit.CircuitName, leftW)) + "\n"
 
 	// Race card
 	live := time.Now().After(m.race.Start) && time.Now().Before(m.race.End)
@@ -51,7 +109,7 @@ func (m Model) View() string {
 		raceHeader += " " + LiveBadge.Render("LIVE")
 	}
 
-	card := lipgloss.NewStyle().BorderForeground(brightGreen).Width(36)
+	card := lipgloss.NewStyle().BorderForeground(brightGreen).Width(min(36, leftW))
 	left += card.Render(
 		TitleStyle.Margin(0).Render(raceHeader) + "\n" +
 			lipgloss.NewStyle().Bold(true).Render(m.race.Start.Format("Jan 2 Mon 15:04")),
@@ -86,49 +144,89 @@ func (m Model) View() string {
 	// Right pane: sessions table
 	rightTitle := TitleStyle.Render("Sessions") + "\n"
 	right := rightTitle + m.tbl.View()
+	if m.filter.Focused() {
+		right = TitleStyle.Render("Find GP") + "\n" + m.renderSearch()
+	}
 
 	// Footer
-	footer := LabelStyle.Render("←/→ switch GP • ↑/↓ sessions • Enter view results • c circuit • r refresh • q quit")
+	footer := m.renderHelp()
 
 	// Layout
-	gap := 3
-	leftW := 42
-	ui := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		lipgloss.NewStyle().Width(leftW).Render(leftPane),
-		strings.Repeat(" ", gap),
-		right,
-	) + "\n\n" + footer
-
-	return ui
+	var ui string
+	if m.stacked() {
+		ui = leftPane + "\n\n" + right + "\n\n" + footer
+	} else {
+		gap := 3
+		ui = lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			lipgloss.NewStyle().Width(leftW).Render(leftPane),
+			strings.Repeat(" ", gap),
+			right,
+		) + "\n\n" + footer
+	}
+
+	header := m.renderTabs()
+	if countdown := m.renderCountdown(time.Now()); countdown != "" {
+		sep := "   "
+		if m.stacked() {
+			sep = "\n"
+		}
+		header += sep + countdown
+	}
+	return header + "\n\n" + ui
 }
 
-func (m Model) renderResultsView() string {
-    if m.resultsView.Loading {
-        return TitleStyle.Render("Loading Results...") + "\n" +
-            LabelStyle.Render("Fetching session data...")
-    }
+func (m Model) renderTabs() string {
+	var parts []string
+	for _, t := range tabs {
+		if t.mode == m.mode {
+			parts = append(parts, ActiveTabStyle.Render(t.title))
+		} else {
+			parts = append(parts, TabStyle.Render(t.title))
+		}
+	}
+	return strings.Join(parts, " ")
+}
 
-    if m.resultsView.Error != nil {
-        // Check if race is currently live
-        now := time.Now()
-        isLive := now.After(m.race.Start) && now.Before(m.race.End)
+func (m Model) renderResultsView() string {
+	if m.resultsView.Loading {
+		return TitleStyle.Render("Loading Results...") + "\n" +
+			LabelStyle.Render("Fetching session data...")
+	}
 
-        errorMsg := m.resultsView.Error.Error()
-        if isLive && m.resultsView.SessionName == "Race" {
-            errorMsg = "Live timing not available - results will appear after race completion"
-        }
+	if m.resultsView.Error != nil {
+		errorMsg := m.resultsView.Error.Error()
+		if m.resultsView.Live {
+			errorMsg = fmt.Sprintf("Waiting for live timing, retrying every %s (%s)", liveInterval, errorMsg)
+		}
 
-        return TitleStyle.Render(m.resultsView.RaceName) + "\n" +
-            GPStyle.Render(m.resultsView.SessionName) + "\n" +
-            LabelStyle.Render(errorMsg) + "\n" +
-            LabelStyle.Render("Press ESC or Q to go back")
-    }
+		return TitleStyle.Render(m.resultsView.RaceName) + "\n" +
+			GPStyle.Render(m.resultsView.SessionName) + "\n" +
+			LabelStyle.Render(errorMsg) + "\n" +
+			LabelStyle.Render("Press "+m.keys.Back.Help().Key+" to go back")
+	}
 
-    header := TitleStyle.Render(m.resultsView.RaceName) + "\n" +
-        GPStyle.Render(m.resultsView.SessionName + " Results") + "\n\n"
+	title := m.resultsView.SessionName + " Results"
+	if m.resultsView.Live {
+		title = m.resultsView.SessionName + " " + LiveBadge.Render("LIVE")
+	}
+	header := TitleStyle.Render(m.resultsView.RaceName) + "\n" +
+		GPStyle.Render(title) + "\n"
+	if m.resultsView.Live {
+		header += LabelStyle.Render(fmt.Sprintf("updated %s • refreshing every %s", m.resultsView.UpdatedAt.Format("15:04:05"), liveInterval)) + "\n"
+	}
+	if m.resultsView.RaceControl != "" {
+		header += CircuitStyle.Render("Race control: "+m.resultsView.RaceControl) + "\n"
+	}
+	header += "\n"
 
-    footer := "\n" + LabelStyle.Render("↑/↓ scroll • ESC/Q go back • Ctrl+C quit")
+	footer := "\n" + m.renderHelp()
+	if raceBreakdown(m.resultsView) {
+		footer = "\n" + LabelStyle.Render("+/-: places gained from the grid (PL: pit lane start) • Rk: fastest lap rank, ★ fastest lap") + footer
+	}
+	if qualifyingBreakdown(m.resultsView) {
+		footer = "\n" + LabelStyle.Render("Gap: to pole • To Cut: to the slowest time that went through, Q3 runners show their Q2 margin") + footer
+	}
 
-    return header + m.resultsTbl.View() + footer
+	return header + m.resultsTbl.View() + footer
 }
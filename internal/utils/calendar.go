@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// CalendarSession is one session of a season with the GP it belongs to.
+type CalendarSession struct {
+	Race    models.Race
+	Session models.UISession
+}
+
+// SortRaces returns the races of a schedule in round order, leaving out
+// entries without a name or circuit, such as rounds still to be announced.
+func SortRaces(in []models.Race) []models.Race {
+	out := make([]models.Race, 0, len(in))
+	for _, r := range in {
+		if r.RaceName == "" || r.Circuit.CircuitName == "" {
+			continue
+		}
+		out = append(out, r)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		ri, err1 := strconv.Atoi(out[i].Round)
+		rj, err2 := strconv.Atoi(out[j].Round)
+		if err1 != nil || err2 != nil {
+			return out[i].Round < out[j].Round
+		}
+		return ri < rj
+	})
+	return out
+}
+
+// BuildCalendar lists every session of races with times in loc, skipping
+// GPs whose times cannot be parsed.
+func BuildCalendar(races []models.Race, loc *time.Location) []CalendarSession {
+	var out []CalendarSession
+	for _, r := range races {
+		sessions, race, err := BuildUISessions(r, r.Season, r.Round, loc, func(string, string) string { return "" })
+		if err != nil {
+			continue
+		}
+		for _, s := range append(sessions, race) {
+			out = append(out, CalendarSession{Race: r, Session: s})
+		}
+	}
+	return out
+}
+
+// NextSession is the session running at now, or else the next one to
+// start. ok is false once the calendar is over.
+func NextSession(calendar []CalendarSession, now time.Time) (CalendarSession, bool) {
+	var best CalendarSession
+	found := false
+	for _, c := range calendar {
+		if !now.Before(c.Session.End) {
+			continue
+		}
+		if !found || c.Session.Start.Before(best.Session.Start) {
+			best, found = c, true
+		}
+	}
+	return best, found
+}
+
+// FormatCountdown renders d as "2d 04:13:22", dropping the days under 24h.
+func FormatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	secs := int(d.Seconds())
+	days, secs := secs/86400, secs%86400
+	clock := fmt.Sprintf("%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	if days > 0 {
+		return fmt.Sprintf("%dd %s", days, clock)
+	}
+	return clock
+}
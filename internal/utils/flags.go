@@ -55,7 +55,7 @@ func CountryNameToCode(name, gpName, circuitName string) string {
 		return "GB"
 	case strings.Contains(gpName, "Abu Dhabi"):
 		return "AE"
-	case strings.Contains(gpName, "Baku"):  // Azerbaijan GP
+	case strings.Contains(gpName, "Baku"): // Azerbaijan GP
 		return "AZ"
 	case strings.Contains(gpName, "United States"):
 		return "US"
@@ -63,3 +63,53 @@ func CountryNameToCode(name, gpName, circuitName string) string {
 
 	return ""
 }
+
+// NationalityToCode maps an Ergast nationality ("British", "Dutch", ...) to
+// an ISO 3166 alpha-2 code for CountryCodeToFlag.
+func NationalityToCode(nationality string) string {
+	m := map[string]string{
+		"American":        "US",
+		"Argentine":       "AR",
+		"Argentinian":     "AR",
+		"Australian":      "AU",
+		"Austrian":        "AT",
+		"Belgian":         "BE",
+		"Brazilian":       "BR",
+		"British":         "GB",
+		"Canadian":        "CA",
+		"Chilean":         "CL",
+		"Chinese":         "CN",
+		"Colombian":       "CO",
+		"Czech":           "CZ",
+		"Danish":          "DK",
+		"Dutch":           "NL",
+		"East German":     "DE",
+		"Finnish":         "FI",
+		"French":          "FR",
+		"German":          "DE",
+		"Hong Kong":       "HK",
+		"Hungarian":       "HU",
+		"Indian":          "IN",
+		"Indonesian":      "ID",
+		"Irish":           "IE",
+		"Italian":         "IT",
+		"Japanese":        "JP",
+		"Liechtensteiner": "LI",
+		"Malaysian":       "MY",
+		"Mexican":         "MX",
+		"Monegasque":      "MC",
+		"New Zealander":   "NZ",
+		"Polish":          "PL",
+		"Portuguese":      "PT",
+		"Rhodesian":       "ZW",
+		"Russian":         "RU",
+		"South African":   "ZA",
+		"Spanish":         "ES",
+		"Swedish":         "SE",
+		"Swiss":           "CH",
+		"Thai":            "TH",
+		"Uruguayan":       "UY",
+		"Venezuelan":      "VE",
+	}
+	return m[nationality]
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +33,20 @@ func ToLocal(t time.Time) time.Time {
 	return t.In(time.Local)
 }
 
+// HumanizeAge renders d coarsely: "<1m", "12m", "5h", "3d".
+func HumanizeAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "<1m"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
+
 func ApproxEnd(kind string, start time.Time) time.Time {
 	switch kind {
 	case "Race":
@@ -43,7 +58,12 @@ func ApproxEnd(kind string, start time.Time) time.Time {
 	}
 }
 
-func BuildUISessions(r models.Race, season, round string, resultsURL func(string, string) string) ([]models.UISession, models.UISession, error) {
+// BuildUISessions lists the sessions of r with start times in loc, or in
+// the local zone if loc is nil.
+func BuildUISessions(r models.Race, season, round string, loc *time.Location, resultsURL func(string, string) string) ([]models.UISession, models.UISession, error) {
+	if loc == nil {
+		loc = time.Local
+	}
 	var sessions []models.UISession
 
 	addSession := func(kind string, s *models.Session) error {
@@ -55,7 +75,7 @@ func BuildUISessions(r models.Race, season, round string, resultsURL func(string
 		if err != nil {
 			return err
 		}
-		start := ToLocal(utc)
+		start := utc.In(loc)
 		sessions = append(sessions, models.UISession{
 			Kind:  kind,
 			Start: start,
@@ -85,11 +105,17 @@ func BuildUISessions(r models.Race, season, round string, resultsURL func(string
 		return nil, models.UISession{}, err
 	}
 
-	raceUTC, err := ParseUTC(r.Date, r.Time)
+	// Ergast has no race start times before 2005; fall back to midnight UTC
+	// so historical seasons still list by date.
+	raceTime := r.Time
+	if raceTime == "" {
+		raceTime = "00:00:00Z"
+	}
+	raceUTC, err := ParseUTC(r.Date, raceTime)
 	if err != nil {
 		return nil, models.UISession{}, err
 	}
-	raceLocal := ToLocal(raceUTC)
+	raceLocal := raceUTC.In(loc)
 	race := models.UISession{
 		Kind:  "Race",
 		Start: raceLocal,
@@ -99,3 +125,35 @@ func BuildUISessions(r models.Race, season, round string, resultsURL func(string
 
 	return sessions, race, nil
 }
+
+// ParseLapTime parses an Ergast lap, gap or race time such as "1:32.608",
+// "+5.123" or "1:31:44.742".
+func ParseLapTime(s string) (time.Duration, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(s), "+"), ":")
+
+	secs, err := time.ParseDuration(parts[len(parts)-1] + "s")
+	if err != nil {
+		return 0, fmt.Errorf("invalid lap time %q", s)
+	}
+
+	var mins int
+	for _, p := range parts[:len(parts)-1] {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return 0, fmt.Errorf("invalid lap time %q", s)
+		}
+		mins = mins*60 + n
+	}
+	return time.Duration(mins)*time.Minute + secs, nil
+}
+
+// FormatLapTime renders d as Ergast does lap times: "1:32.608", or
+// "58.114" under a minute.
+func FormatLapTime(d time.Duration) string {
+	ms := d.Milliseconds()
+	mins, ms := ms/60000, ms%60000
+	if mins == 0 {
+		return fmt.Sprintf("%d.%03d", ms/1000, ms%1000)
+	}
+	return fmt.Sprintf("%d:%02d.%03d", mins, ms/1000, ms%1000)
+}
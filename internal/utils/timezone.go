@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/kashifulhaque/f1-tui/internal/models"
+)
+
+// circuitZones maps Ergast circuit IDs to the IANA zone of the track.
+var circuitZones = map[string]string{
+	"adelaide":       "Australia/Adelaide",
+	"albert_park":    "Australia/Melbourne",
+	"americas":       "America/Chicago",
+	"bahrain":        "Asia/Bahrain",
+	"baku":           "Asia/Baku",
+	"buddh":          "Asia/Kolkata",
+	"catalunya":      "Europe/Madrid",
+	"estoril":        "Europe/Lisbon",
+	"fuji":           "Asia/Tokyo",
+	"hockenheimring": "Europe/Berlin",
+	"hungaroring":    "Europe/Budapest",
+	"imola":          "Europe/Rome",
+	"indianapolis":   "America/Indiana/Indianapolis",
+	"interlagos":     "America/Sao_Paulo",
+	"istanbul":       "Europe/Istanbul",
+	"jeddah":         "Asia/Riyadh",
+	"kyalami":        "Africa/Johannesburg",
+	"las_vegas":      "America/Los_Angeles",
+	"losail":         "Asia/Qatar",
+	"madring":        "Europe/Madrid",
+	"magny_cours":    "Europe/Paris",
+	"marina_bay":     "Asia/Singapore",
+	"miami":          "America/New_York",
+	"monaco":         "Europe/Monaco",
+	"monza":          "Europe/Rome",
+	"mugello":        "Europe/Rome",
+	"nurburgring":    "Europe/Berlin",
+	"portimao":       "Europe/Lisbon",
+	"red_bull_ring":  "Europe/Vienna",
+	"ricard":         "Europe/Paris",
+	"rodriguez":      "America/Mexico_City",
+	"sepang":         "Asia/Kuala_Lumpur",
+	"shanghai":       "Asia/Shanghai",
+	"silverstone":    "Europe/London",
+	"sochi":          "Europe/Moscow",
+	"spa":            "Europe/Brussels",
+	"suzuka":         "Asia/Tokyo",
+	"valencia":       "Europe/Madrid",
+	"vegas":          "America/Los_Angeles",
+	"villeneuve":     "America/Toronto",
+	"yas_marina":     "Asia/Dubai",
+	"yeongam":        "Asia/Seoul",
+	"zandvoort":      "Europe/Amsterdam",
+}
+
+// CircuitLocation is the time zone of c. Circuits missing from the bundled
+// table get a fixed offset derived from their longitude, which ignores
+// daylight saving but is right to the hour most of the year.
+func CircuitLocation(c models.Circuit) *time.Location {
+	if name, ok := circuitZones[c.CircuitID]; ok {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+
+	long, err := strconv.ParseFloat(c.Location.Long, 64)
+	if err != nil {
+		return time.UTC
+	}
+	hours := int(math.Round(long / 15))
+	if hours == 0 {
+		return time.UTC
+	}
+	return time.FixedZone(fmt.Sprintf("UTC%+d", hours), hours*3600)
+}
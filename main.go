@@ -1,17 +1,11 @@
 package main
 
 import (
-	"fmt"
 	"os"
 
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/kashifulhaque/f1-tui/internal/ui"
+	"github.com/kashifulhaque/f1-tui/internal/cli"
 )
 
 func main() {
-	p := tea.NewProgram(ui.InitialModel(), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
-		fmt.Println("error:", err)
-		os.Exit(1)
-	}
+	os.Exit(cli.Main(os.Args[1:]))
 }